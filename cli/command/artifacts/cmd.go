@@ -0,0 +1,177 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/cli/command"
+	"github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/credentials"
+	"github.com/saucelabs/saucectl/internal/download"
+	"github.com/saucelabs/saucectl/internal/job"
+	"github.com/saucelabs/saucectl/internal/rdc"
+	"github.com/saucelabs/saucectl/internal/region"
+	"github.com/saucelabs/saucectl/internal/requesth"
+	"github.com/saucelabs/saucectl/internal/resto"
+	"github.com/saucelabs/saucectl/internal/sentry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	artifactsUse   = "artifacts"
+	artifactsShort = "Manage the artifacts of past jobs"
+
+	downloadUse   = "download"
+	downloadShort = "Download the artifacts of past jobs"
+	downloadLong  = `Download the artifacts (e.g. videos, logs and reports) of a job, or of every job that belongs to a build.
+The artifacts of each job are stored in a folder named after the job ID. Jobs of a build are only searched for among
+the most recent jobs, as set by --limit.`
+	downloadExample = `saucectl artifacts download --job 9c4e0a1b2d3f4e5a6b7c8d9e0f1a2b3c --match "*.mp4"
+saucectl artifacts download --build "Release 1.2.3" --dir ./artifacts`
+
+	requestTimeout = 30 * time.Second
+)
+
+// flags contains all flags of the download command.
+var flags = struct {
+	region string
+	rdc    bool
+	jobID  string
+	build  string
+	match  []string
+	dir    string
+	limit  int
+}{}
+
+// downloader is the union of all operations that are needed to download the artifacts of past jobs.
+type downloader interface {
+	job.Lister
+	download.ArtifactDownloader
+}
+
+// Command creates the `artifacts` command
+func Command(cli *command.SauceCtlCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   artifactsUse,
+		Short: artifactsShort,
+	}
+	cmd.AddCommand(DownloadCommand())
+	return cmd
+}
+
+// DownloadCommand creates the `artifacts download` command
+func DownloadCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     downloadUse,
+		Short:   downloadShort,
+		Long:    downloadLong,
+		Example: downloadExample,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := Download(); err != nil {
+				log.Err(err).Msg("failed to execute artifacts download command")
+				sentry.CaptureError(err, sentry.Scope{})
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.region, "region", "r", region.USWest1.String(), "The sauce labs region.")
+	cmd.Flags().BoolVar(&flags.rdc, "rdc", false, "Targets real device jobs.")
+	cmd.Flags().StringVar(&flags.jobID, "job", "", "The ID of the job whose artifacts to download.")
+	cmd.Flags().StringVar(&flags.build, "build", "", "The name of the build whose jobs' artifacts to download.")
+	cmd.Flags().StringSliceVar(&flags.match, "match", []string{"*"}, "Specifies which artifacts to download.")
+	cmd.Flags().StringVar(&flags.dir, "dir", ".", "The directory to download artifacts to.")
+	cmd.Flags().IntVar(&flags.limit, "limit", 100, "The number of most recent jobs that are searched for jobs of the build.")
+	return cmd
+}
+
+// Download downloads the artifacts of the job or build selected by flags.
+func Download() error {
+	if (flags.jobID == "") == (flags.build == "") {
+		return errors.New("either --job or --build must be set")
+	}
+
+	d, err := newDownloader(config.ArtifactDownload{Match: flags.match, Directory: flags.dir})
+	if err != nil {
+		return err
+	}
+
+	ids := []string{flags.jobID}
+	if flags.build != "" {
+		if ids, err = buildJobs(d, flags.build, flags.limit); err != nil {
+			return err
+		}
+	}
+
+	return downloadJobs(d, ids)
+}
+
+// downloadJobs downloads the artifacts of the jobs with the given IDs. A job whose artifacts fail to download doesn't
+// stop the others from being downloaded, but fails the command.
+func downloadJobs(d download.ArtifactDownloader, ids []string) error {
+	failed := 0
+	for _, id := range ids {
+		log.Info().Str("id", id).Msg("Downloading artifacts.")
+		if err := d.DownloadArtifact(context.Background(), id); err != nil {
+			log.Error().Err(err).Str("id", id).Msg("Failed to download artifacts.")
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to download the artifacts of %d of %d job(s)", failed, len(ids))
+	}
+
+	log.Info().Int("jobs", len(ids)).Str("dir", flags.dir).Msg("Downloaded artifacts.")
+	return nil
+}
+
+// buildJobs returns the IDs of all jobs of the given build among the most recent jobs.
+func buildJobs(l job.Lister, build string, limit int) ([]string, error) {
+	jobs, err := l.ListJobs(context.Background(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, j := range jobs {
+		if j.Build == build {
+			ids = append(ids, j.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no jobs found for build '%s' among the %d most recent jobs", build, limit)
+	}
+	return ids, nil
+}
+
+// newDownloader returns the client that downloads the artifacts of the type of jobs selected by flags.
+func newDownloader(cfg config.ArtifactDownload) (downloader, error) {
+	creds := credentials.Get()
+	if !creds.IsValid() {
+		return nil, errors.New("no credentials set, run 'saucectl configure' first")
+	}
+
+	regio := region.FromString(flags.region)
+	if regio == region.None {
+		return nil, fmt.Errorf("unknown region '%s'", flags.region)
+	}
+
+	if flags.rdc {
+		c := rdc.New(regio.APIBaseURL(), creds.Username, creds.AccessKey, requestTimeout, cfg)
+		return &c, nil
+	}
+
+	c := resto.Client{
+		HTTPClient:     requesth.NewClient(requestTimeout),
+		URL:            regio.APIBaseURL(),
+		Username:       creds.Username,
+		AccessKey:      creds.AccessKey,
+		ArtifactConfig: cfg,
+	}
+	return &c, nil
+}
@@ -0,0 +1,55 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/saucelabs/saucectl/internal/job"
+	"github.com/saucelabs/saucectl/internal/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeLister struct {
+	jobs []job.Job
+	err  error
+}
+
+func (f fakeLister) ListJobs(ctx context.Context, limit int) ([]job.Job, error) {
+	return f.jobs, f.err
+}
+
+func TestBuildJobs(t *testing.T) {
+	l := fakeLister{jobs: []job.Job{
+		{ID: "1", Build: "nightly"},
+		{ID: "2", Build: "smoke"},
+		{ID: "3", Build: "nightly"},
+	}}
+
+	ids, err := buildJobs(l, "nightly", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "3"}, ids)
+
+	_, err = buildJobs(l, "release", 10)
+	assert.EqualError(t, err, "no jobs found for build 'release' among the 10 most recent jobs")
+
+	_, err = buildJobs(fakeLister{err: errors.New("boom")}, "nightly", 10)
+	assert.EqualError(t, err, "boom")
+}
+
+func TestDownloadJobs(t *testing.T) {
+	var downloaded []string
+	d := &mocks.FakeArifactDownloader{
+		DownloadArtifactFn: func(ctx context.Context, jobID string) error {
+			downloaded = append(downloaded, jobID)
+			if jobID == "bad" {
+				return errors.New("job not found")
+			}
+			return nil
+		},
+	}
+
+	assert.NoError(t, downloadJobs(d, []string{"1", "2"}))
+	assert.EqualError(t, downloadJobs(d, []string{"bad", "3"}), "failed to download the artifacts of 1 of 2 job(s)")
+	assert.Equal(t, []string{"1", "2", "bad", "3"}, downloaded)
+}
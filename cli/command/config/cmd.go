@@ -0,0 +1,63 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/cli/command"
+	sauceconfig "github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/sentry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	configUse   = "config"
+	configShort = "Inspect saucectl config files"
+
+	printUse     = "print"
+	printShort   = "Print the resolved config"
+	printLong    = `Print the config as saucectl sees it, after all files referenced via 'extends' and 'include' have been merged.`
+	printExample = "saucectl config print -c .sauce/smoke.yml"
+
+	cfgFilePath = ""
+)
+
+// Command creates the `config` command
+func Command(cli *command.SauceCtlCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   configUse,
+		Short: configShort,
+	}
+	cmd.AddCommand(PrintCommand(cli))
+	return cmd
+}
+
+// PrintCommand creates the `config print` command
+func PrintCommand(cli *command.SauceCtlCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     printUse,
+		Short:   printShort,
+		Long:    printLong,
+		Example: printExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := Print(cfgFilePath); err != nil {
+				log.Err(err).Msg("failed to execute config print command")
+				sentry.CaptureError(err, sentry.Scope{})
+				os.Exit(1)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&cfgFilePath, "config", "c", ".sauce/config.yml", "config file to print")
+	return cmd
+}
+
+// Print prints the resolved config at cfgPath.
+func Print(cfgPath string) error {
+	b, err := sauceconfig.Resolve(cfgPath)
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(b))
+	return nil
+}
@@ -0,0 +1,92 @@
+package ignore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/saucelabs/saucectl/internal/sauceignore"
+	"github.com/spf13/cobra"
+)
+
+// CheckCommand creates the `ignore check` command
+func CheckCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check <path>...",
+		Short: "Show whether files are excluded from the bundle, and by which pattern",
+		Long: `Show whether files are excluded from the bundle, and by which pattern of which ignore file.
+Paths are relative to the current working directory, just like the rootDir in the config file.`,
+		Example: "saucectl ignore check node_modules/lodash/index.js cypress/videos",
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			run(cmd, "ignore check", func(s settings) error {
+				return Check(s, args)
+			})
+		},
+	}
+}
+
+// Check prints, for each of paths, whether it is excluded from the bundle and which pattern decides so.
+func Check(s settings, paths []string) error {
+	ps, err := sauceignore.PatternsFromDir(s.RootDir, s.Sauce.Sauceignore, s.ignoreOptions())
+	if err != nil {
+		return err
+	}
+
+	for _, p := range paths {
+		verdict, err := explain(ps, p)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: %s\n", p, verdict)
+	}
+	return nil
+}
+
+// explain returns whether the file at path is excluded by any of ps, and why.
+func explain(ps []sauceignore.Pattern, path string) (string, error) {
+	// Paths that don't exist (yet) are treated as files, unless they end with a slash.
+	isDir := strings.HasSuffix(path, "/")
+	if fi, err := os.Stat(path); err == nil {
+		isDir = fi.IsDir()
+	}
+
+	if filepath.IsAbs(path) {
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		if path, err = filepath.Rel(wd, path); err != nil {
+			return "", err
+		}
+	}
+	parts := strings.Split(filepath.Clean(path), string(os.PathSeparator))
+
+	// Excluding a directory excludes everything in it, regardless of any patterns that match its content.
+	for i := 1; i <= len(parts); i++ {
+		last := i == len(parts)
+		p, excluded, ok := sauceignore.Explain(ps, parts[:i], !last || isDir)
+		if !ok {
+			if last {
+				return "included, no pattern matches", nil
+			}
+			continue
+		}
+
+		rule := fmt.Sprintf("%s:%d '%s'", p.File, p.Line, p.P)
+		if p.File == "" {
+			rule = fmt.Sprintf("built-in pattern '%s'", p.P)
+		}
+		if excluded && !last {
+			return fmt.Sprintf("excluded by %s, which matches the directory %s", rule, strings.Join(parts[:i], "/")), nil
+		}
+		if excluded {
+			return fmt.Sprintf("excluded by %s", rule), nil
+		}
+		if last {
+			return fmt.Sprintf("included by %s", rule), nil
+		}
+	}
+	return "included, no pattern matches", nil
+}
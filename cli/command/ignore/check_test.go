@@ -0,0 +1,42 @@
+package ignore
+
+import (
+	"testing"
+
+	"github.com/saucelabs/saucectl/internal/sauceignore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_explain(t *testing.T) {
+	ps := []sauceignore.Pattern{
+		{P: ".git/"},
+		{P: "node_modules/", File: ".sauceignore", Line: 1},
+		{P: "*.mp4", File: ".sauceignore", Line: 2},
+		{P: "!keep.mp4", File: ".sauceignore", Line: 3},
+		{P: "cypress/", File: ".sauceignore", Line: 4},
+		{P: "!cypress/videos/v.mp4", File: ".sauceignore", Line: 5},
+	}
+
+	testCases := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "no match", path: "src/a.js", want: "included, no pattern matches"},
+		{name: "excluded file", path: "src/b.mp4", want: "excluded by .sauceignore:2 '*.mp4'"},
+		{name: "negated file", path: "src/keep.mp4", want: "included by .sauceignore:3 '!keep.mp4'"},
+		{name: "excluded parent", path: "node_modules/a/x.js",
+			want: "excluded by .sauceignore:1 'node_modules/', which matches the directory node_modules"},
+		{name: "negation within excluded parent", path: "cypress/videos/v.mp4",
+			want: "excluded by .sauceignore:4 'cypress/', which matches the directory cypress"},
+		{name: "directory", path: "cypress/", want: "excluded by .sauceignore:4 'cypress/'"},
+		{name: "built-in pattern", path: ".git/config", want: "excluded by built-in pattern '.git/', which matches the directory .git"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := explain(ps, tc.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
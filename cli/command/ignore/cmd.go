@@ -0,0 +1,111 @@
+package ignore
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/cli/command"
+	"github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/sauceignore"
+	"github.com/saucelabs/saucectl/internal/sentry"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	ignoreUse   = "ignore"
+	ignoreShort = "Debug .sauceignore rules"
+	ignoreLong  = `Show which files .sauceignore excludes from the project bundle.
+Besides the .sauceignore file, nested .sauceignore files and built-in defaults, such as .git/, apply.
+The root directory, .sauceignore file and symlink handling are taken from the config file, if it exists.`
+)
+
+// flags contains all flags that are shared by the ignore subcommands.
+var flags = struct {
+	cfgFilePath  string
+	sauceignore  string
+	rootDir      string
+	useGitignore bool
+	mode         string
+}{}
+
+// settings represents the parts of a config file that determine which files are bundled.
+type settings struct {
+	RootDir string `yaml:"rootDir"`
+	Sauce   struct {
+		Sauceignore      string `yaml:"sauceignore"`
+		PreserveSymlinks bool   `yaml:"preserveSymlinks"`
+		UseGitignore     bool   `yaml:"useGitignore"`
+	} `yaml:"sauce"`
+}
+
+// Command creates the `ignore` command
+func Command(cli *command.SauceCtlCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   ignoreUse,
+		Short: ignoreShort,
+		Long:  ignoreLong,
+	}
+
+	cmd.PersistentFlags().StringVarP(&flags.cfgFilePath, "config", "c", ".sauce/config.yml", "Specifies which config file to use.")
+	cmd.PersistentFlags().StringVar(&flags.sauceignore, "sauceignore", "", "Specifies the path to the .sauceignore file. (default: .sauceignore)")
+	cmd.PersistentFlags().StringVar(&flags.rootDir, "root-dir", "", "Specifies the directory that is bundled. (default: .)")
+	cmd.PersistentFlags().BoolVar(&flags.useGitignore, "use-gitignore", false, "Excludes files listed in .gitignore files as well.")
+
+	cmd.AddCommand(
+		CheckCommand(),
+		ListCommand(),
+	)
+	return cmd
+}
+
+// loadSettings reads the settings from the config file and applies flags on top. A missing config file is only an
+// error if it was requested explicitly.
+func loadSettings(cmd *cobra.Command) (settings, error) {
+	var s settings
+
+	b, err := config.Resolve(flags.cfgFilePath)
+	if err != nil && (cmd.Flag("config").Changed || !os.IsNotExist(err)) {
+		return s, err
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(b, &s); err != nil {
+			return s, err
+		}
+	}
+
+	if flags.sauceignore != "" {
+		s.Sauce.Sauceignore = flags.sauceignore
+	}
+	if s.Sauce.Sauceignore == "" {
+		s.Sauce.Sauceignore = ".sauceignore"
+	}
+	if cmd.Flag("use-gitignore").Changed {
+		s.Sauce.UseGitignore = flags.useGitignore
+	}
+	if flags.rootDir != "" {
+		s.RootDir = flags.rootDir
+	}
+	if s.RootDir == "" {
+		s.RootDir = "."
+	}
+	return s, nil
+}
+
+// ignoreOptions returns the options that select the ignore files of the project.
+func (s settings) ignoreOptions() sauceignore.Options {
+	return sauceignore.Options{UseGitignore: s.Sauce.UseGitignore}
+}
+
+// run executes fn with the settings of cmd and terminates saucectl if it fails.
+func run(cmd *cobra.Command, name string, fn func(s settings) error) {
+	s, err := loadSettings(cmd)
+	if err == nil {
+		err = fn(s)
+	}
+	if err != nil {
+		log.Err(err).Msgf("failed to execute %s command", name)
+		sentry.CaptureError(err, sentry.Scope{})
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,103 @@
+package ignore
+
+import (
+	archTar "archive/tar"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/internal/archive/tar"
+	"github.com/saucelabs/saucectl/internal/archive/zip"
+	"github.com/saucelabs/saucectl/internal/sauceignore"
+	"github.com/spf13/cobra"
+)
+
+// ListCommand creates the `ignore ls` command
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "List the files that would be bundled",
+		Long: `List the files that would be bundled. In sauce mode, the project is zipped and uploaded to Sauce Labs.
+In docker mode, the project is copied into the container as a tar archive, unless it is mounted.`,
+		Example: "saucectl ignore ls --mode docker",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			run(cmd, "ignore ls", func(s settings) error {
+				return List(s, flags.mode)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&flags.mode, "mode", "sauce", "Specifies how the project would be bundled. Choice: sauce|docker.")
+	return cmd
+}
+
+// List prints the files that are bundled in the given mode.
+func List(s settings, mode string) error {
+	matcher, err := sauceignore.NewMatcherFromDir(s.RootDir, s.Sauce.Sauceignore, s.ignoreOptions())
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	switch mode {
+	case "sauce":
+		names, err = zipEntries(s, matcher)
+	case "docker":
+		names, err = tarEntries(s, matcher)
+	default:
+		return fmt.Errorf("unknown mode '%s', choose sauce or docker", mode)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, n := range names {
+		fmt.Println(n)
+	}
+	log.Info().Int("files", len(names)).Str("mode", mode).Msg("Listed bundled files.")
+	return nil
+}
+
+// zipEntries returns the names of the files that the cloud runner bundles, excluding its runner config.
+func zipEntries(s settings, matcher sauceignore.Matcher) ([]string, error) {
+	z, err := zip.New(io.Discard, matcher, zip.Options{PreserveSymlinks: s.Sauce.PreserveSymlinks})
+	if err != nil {
+		return nil, err
+	}
+	defer z.Close()
+
+	if err := z.AddContents(s.RootDir, ""); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range z.Entries {
+		names = append(names, e.Name)
+	}
+	return names, nil
+}
+
+// tarEntries returns the names of the files that the docker runner copies into the container.
+func tarEntries(s settings, matcher sauceignore.Matcher) ([]string, error) {
+	r, err := tar.Archive(s.RootDir, matcher, tar.Options{PreserveSymlinks: s.Sauce.PreserveSymlinks})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var names []string
+	tr := archTar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return names, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != archTar.TypeDir {
+			names = append(names, h.Name)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package ignore
+
+import (
+	"testing"
+
+	"github.com/saucelabs/saucectl/internal/sauceignore"
+	"github.com/stretchr/testify/assert"
+	"gotest.tools/v3/fs"
+)
+
+func Test_zipEntries(t *testing.T) {
+	dir := fs.NewDir(t, "project",
+		fs.WithFile("index.js", ""),
+		fs.WithDir("node_modules",
+			fs.WithFile("dep.js", ""),
+			fs.WithSymlink("project", ".."),
+		),
+	)
+	defer dir.Remove()
+
+	s := settings{RootDir: dir.Path()}
+	got, err := zipEntries(s, sauceignore.NewMatcher(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"index.js", "node_modules/dep.js"}, got)
+}
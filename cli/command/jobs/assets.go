@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+// AssetsCommand creates the `jobs assets` command
+func AssetsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "assets <id>",
+		Short:   "List the assets of a job",
+		Example: "saucectl jobs assets 9c4e0a1b2d3f4e5a6b7c8d9e0f1a2b3c",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			run("jobs assets", func() error {
+				return Assets(args[0])
+			})
+		},
+	}
+}
+
+// Assets prints the names of the assets of the job with the given id.
+func Assets(id string) error {
+	svc, _, err := newService()
+	if err != nil {
+		return err
+	}
+
+	files, err := svc.GetJobAssetFileNames(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if flags.json {
+		return printJSON(files)
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(table.StyleLight)
+	t.AppendHeader(table.Row{"Asset"})
+	for _, f := range files {
+		t.AppendRow(table.Row{f})
+	}
+	t.Render()
+	return nil
+}
@@ -0,0 +1,130 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/cli/command"
+	"github.com/saucelabs/saucectl/internal/credentials"
+	"github.com/saucelabs/saucectl/internal/job"
+	"github.com/saucelabs/saucectl/internal/rdc"
+	"github.com/saucelabs/saucectl/internal/region"
+	"github.com/saucelabs/saucectl/internal/requesth"
+	"github.com/saucelabs/saucectl/internal/resto"
+	"github.com/saucelabs/saucectl/internal/sentry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	jobsUse   = "jobs"
+	jobsShort = "Inspect and stop jobs on Sauce Labs"
+	jobsLong  = `Inspect and stop jobs that run on Sauce Labs, e.g. to clean up after a CI run got killed.
+Real device jobs are managed separately from emulator, simulator and browser jobs and require the --rdc flag.`
+
+	requestTimeout = 30 * time.Second
+)
+
+// flags contains all flags that are shared by the jobs subcommands.
+var flags = struct {
+	region string
+	rdc    bool
+	json   bool
+}{}
+
+// service is the union of all job related operations that the subcommands need.
+type service interface {
+	job.Reader
+	job.Stopper
+	job.Lister
+}
+
+// Command creates the `jobs` command
+func Command(cli *command.SauceCtlCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   jobsUse,
+		Short: jobsShort,
+		Long:  jobsLong,
+	}
+
+	cmd.PersistentFlags().StringVarP(&flags.region, "region", "r", region.USWest1.String(), "The sauce labs region.")
+	cmd.PersistentFlags().BoolVar(&flags.rdc, "rdc", false, "Targets real device jobs.")
+	cmd.PersistentFlags().BoolVar(&flags.json, "json", false, "Prints the output as json.")
+
+	cmd.AddCommand(
+		ListCommand(),
+		GetCommand(),
+		StopCommand(),
+		AssetsCommand(),
+	)
+	return cmd
+}
+
+// newService returns the service that manages the type of jobs selected by flags.
+func newService() (service, region.Region, error) {
+	creds := credentials.Get()
+	if !creds.IsValid() {
+		return nil, region.None, errors.New("no credentials set, run 'saucectl configure' first")
+	}
+
+	regio := region.FromString(flags.region)
+	if regio == region.None {
+		return nil, region.None, fmt.Errorf("unknown region '%s'", flags.region)
+	}
+
+	if flags.rdc {
+		return &rdc.Client{
+			HTTPClient: requesth.NewClient(requestTimeout),
+			URL:        regio.APIBaseURL(),
+			Username:   creds.Username,
+			AccessKey:  creds.AccessKey,
+		}, regio, nil
+	}
+
+	c := resto.New(regio.APIBaseURL(), creds.Username, creds.AccessKey, requestTimeout)
+	return &c, regio, nil
+}
+
+// run executes fn and terminates saucectl if it fails.
+func run(name string, fn func() error) {
+	if err := fn(); err != nil {
+		log.Err(err).Msgf("failed to execute %s command", name)
+		sentry.CaptureError(err, sentry.Scope{})
+		os.Exit(1)
+	}
+}
+
+// renderJobs prints jobs either as a table or as json.
+func renderJobs(jobs []job.Job, regio region.Region) error {
+	if flags.json {
+		return printJSON(jobs)
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(table.StyleLight)
+	t.AppendHeader(table.Row{"ID", "Name", "Status", "Passed", "Platform", "Device", "URL"})
+	for _, j := range jobs {
+		t.AppendRow(table.Row{j.ID, j.Name, j.Status, j.Passed, j.BaseConfig.PlatformName, j.BaseConfig.DeviceName,
+			jobDetailsPage(regio, j.ID)})
+	}
+	t.Render()
+	return nil
+}
+
+func printJSON(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+func jobDetailsPage(regio region.Region, jobID string) string {
+	return fmt.Sprintf("%s/tests/%s", regio.AppBaseURL(), jobID)
+}
@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/saucelabs/saucectl/internal/job"
+	"github.com/spf13/cobra"
+)
+
+// GetCommand creates the `jobs get` command
+func GetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "get <id>",
+		Short:   "Show the details of a job",
+		Example: "saucectl jobs get 9c4e0a1b2d3f4e5a6b7c8d9e0f1a2b3c",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			run("jobs get", func() error {
+				return Get(args[0])
+			})
+		},
+	}
+}
+
+// Get prints the details of the job with the given id.
+func Get(id string) error {
+	svc, regio, err := newService()
+	if err != nil {
+		return err
+	}
+
+	j, err := svc.ReadJob(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if flags.json {
+		return printJSON(j)
+	}
+	return renderJobs([]job.Job{j}, regio)
+}
@@ -0,0 +1,38 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+)
+
+var limit = 20
+
+// ListCommand creates the `jobs list` command
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the most recent jobs",
+		Example: "saucectl jobs list --limit 50",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			run("jobs list", List)
+		},
+	}
+	cmd.Flags().IntVar(&limit, "limit", limit, "The maximum number of jobs to list.")
+	return cmd
+}
+
+// List prints the most recent jobs.
+func List() error {
+	svc, regio, err := newService()
+	if err != nil {
+		return err
+	}
+
+	jobs, err := svc.ListJobs(context.Background(), limit)
+	if err != nil {
+		return err
+	}
+	return renderJobs(jobs, regio)
+}
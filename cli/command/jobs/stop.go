@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// StopCommand creates the `jobs stop` command
+func StopCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "stop <id>...",
+		Short:   "Stop one or more running jobs",
+		Example: "saucectl jobs stop 9c4e0a1b2d3f4e5a6b7c8d9e0f1a2b3c",
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			run("jobs stop", func() error {
+				return Stop(args)
+			})
+		},
+	}
+}
+
+// Stop stops the jobs with the given ids. All jobs are attempted to be stopped, even if stopping one of them fails.
+func Stop(ids []string) error {
+	svc, regio, err := newService()
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, id := range ids {
+		j, err := svc.StopJob(context.Background(), id)
+		if err != nil {
+			log.Error().Err(err).Str("id", id).Msg("Failed to stop job.")
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		log.Info().Str("id", id).Str("status", j.Status).Str("url", jobDetailsPage(regio, id)).Msg("Stopped job.")
+	}
+	return firstErr
+}
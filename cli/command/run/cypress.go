@@ -1,6 +1,7 @@
 package run
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/rs/zerolog/log"
@@ -8,13 +9,15 @@ import (
 	"github.com/saucelabs/saucectl/internal/cypress"
 	"github.com/saucelabs/saucectl/internal/docker"
 	"github.com/saucelabs/saucectl/internal/region"
+	"github.com/saucelabs/saucectl/internal/report"
 	"github.com/saucelabs/saucectl/internal/resto"
 	"github.com/saucelabs/saucectl/internal/saucecloud"
+	"github.com/saucelabs/saucectl/internal/shard"
 	"github.com/saucelabs/saucectl/internal/testcomposer"
 	"github.com/spf13/cobra"
 )
 
-func runCypress(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (int, error) {
+func runCypress(ctx context.Context, cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (exitCode int, err error) {
 	p, err := cypress.FromFile(gFlags.cfgFilePath)
 	if err != nil {
 		return 1, err
@@ -22,7 +25,11 @@ func runCypress(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as
 
 	p.Sauce.Metadata.ExpandEnv()
 	applyDefaultValues(&p.Sauce)
-	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts)
+	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts, &p.Reporters)
+	if err := configureNetwork(cmd, p.Sauce.Network); err != nil {
+		return 1, err
+	}
+	checkForUpdates()
 
 	// Merge env from CLI args and job config. CLI args take precedence.
 	for k, v := range gFlags.env {
@@ -54,6 +61,13 @@ func runCypress(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as
 		if s.Mode == "" {
 			s.Mode = p.Defaults.Mode
 		}
+		if s.Retries == nil || flagChanged(cmd, "retries") {
+			retries := p.Sauce.Retries
+			s.Retries = &retries
+		}
+		if s.Timeout == 0 {
+			s.Timeout = p.Sauce.SuiteTimeout
+		}
 		p.Suites[i] = s
 	}
 	if gFlags.testEnv != "" {
@@ -67,6 +81,11 @@ func runCypress(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as
 		return 1, err
 	}
 
+	cache := shard.NewCache(shard.DefaultCachePath())
+	if err := cypress.ShardSuites(&p, cache); err != nil {
+		return 1, err
+	}
+
 	regio := region.FromString(p.Sauce.Region)
 	if regio == region.None {
 		log.Error().Str("region", gFlags.regionFlag).Msg("Unable to determine sauce region.")
@@ -79,38 +98,43 @@ func runCypress(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as
 
 	rs.ArtifactConfig = p.Artifacts.Download
 
+	// Both halves of a mixed run report to the same reporters, so that they render a single report.
+	reps := append(createReporters(p.Reporters), cache)
+	defer func() { renderReporters(reps, exitCode) }()
+
 	dockerProject, sauceProject := cypress.SplitSuites(p)
 	if len(dockerProject.Suites) != 0 {
-		exitCode, err := runCypressInDocker(dockerProject, tc, rs)
+		exitCode, err := runCypressInDocker(ctx, dockerProject, tc, rs, reps)
 		if err != nil || exitCode != 0 {
 			return exitCode, err
 		}
 	}
 	if len(sauceProject.Suites) != 0 {
-		return runCypressInSauce(sauceProject, regio, tc, rs, as)
+		return runCypressInSauce(ctx, sauceProject, regio, tc, rs, as, reps)
 	}
 
 	return 0, nil
 }
 
-func runCypressInDocker(p cypress.Project, testco testcomposer.Client, rs resto.Client) (int, error) {
+func runCypressInDocker(ctx context.Context, p cypress.Project, testco testcomposer.Client, rs resto.Client, reps []report.Reporter) (int, error) {
 	log.Info().Msg("Running Cypress in Docker")
 	printTestEnv("docker")
 
-	cd, err := docker.NewCypress(p, &testco, &testco, &rs)
+	cd, err := docker.NewCypress(ctx, p, &testco, &testco, &rs, &rs, reps)
 	if err != nil {
 		return 1, err
 	}
 	return cd.RunProject()
 }
 
-func runCypressInSauce(p cypress.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (int, error) {
+func runCypressInSauce(ctx context.Context, p cypress.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore, reps []report.Reporter) (int, error) {
 	log.Info().Msg("Running Cypress in Sauce Labs")
 	printTestEnv("sauce")
 
 	r := saucecloud.CypressRunner{
 		Project: p,
 		CloudRunner: saucecloud.CloudRunner{
+			Ctx:                ctx,
 			ProjectUploader:    as,
 			JobStarter:         &tc,
 			JobReader:          &rs,
@@ -121,6 +145,7 @@ func runCypressInSauce(p cypress.Project, regio region.Region, tc testcomposer.C
 			Region:             regio,
 			ShowConsoleLog:     p.ShowConsoleLog,
 			ArtifactDownloader: &rs,
+			Reporters:          reps,
 			DryRun:             gFlags.dryRun,
 		},
 	}
@@ -1,9 +1,9 @@
 package run
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"os"
 	"path/filepath"
 
@@ -18,6 +18,7 @@ import (
 	"github.com/saucelabs/saucectl/internal/espresso"
 	"github.com/saucelabs/saucectl/internal/rdc"
 	"github.com/saucelabs/saucectl/internal/region"
+	"github.com/saucelabs/saucectl/internal/requesth"
 	"github.com/saucelabs/saucectl/internal/resto"
 	"github.com/saucelabs/saucectl/internal/saucecloud"
 	"github.com/saucelabs/saucectl/internal/sentry"
@@ -81,8 +82,8 @@ func NewEspressoCmd(cli *command.SauceCtlCli) *cobra.Command {
 // runEspressoCmd runs the espresso 'run' command.
 func runEspressoCmd(cmd *cobra.Command, cli *command.SauceCtlCli, args []string) (int, error) {
 	println("Running version", version.Version)
-	checkForUpdates()
-	go awaitGlobalTimeout()
+	ctx, cancel := newRunContext(gFlags.globalTimeout)
+	defer cancel()
 
 	creds := credentials.Get()
 	if !creds.IsValid() {
@@ -99,6 +100,7 @@ func runEspressoCmd(cmd *cobra.Command, cli *command.SauceCtlCli, args []string)
 	}
 	cli.LogDir = gFlags.cfgLogDir
 	log.Info().Str("config", gFlags.cfgFilePath).Msg("Reading config file")
+	config.AllowUnknownKeys = gFlags.allowUnknownKeys
 
 	d, err := config.Describe(gFlags.cfgFilePath)
 	if err != nil {
@@ -106,43 +108,46 @@ func runEspressoCmd(cmd *cobra.Command, cli *command.SauceCtlCli, args []string)
 	}
 
 	tc := testcomposer.Client{
-		HTTPClient:  &http.Client{Timeout: testComposerTimeout},
+		HTTPClient:  requesth.NewClient(testComposerTimeout),
 		URL:         "", // updated later once region is determined
 		Credentials: creds,
 	}
 
 	rs := resto.Client{
-		HTTPClient: &http.Client{Timeout: restoTimeout},
+		HTTPClient: requesth.NewClient(restoTimeout),
 		URL:        "", // updated later once region is determined
 		Username:   creds.Username,
 		AccessKey:  creds.AccessKey,
 	}
 
 	rc := rdc.Client{
-		HTTPClient: &http.Client{
-			Timeout: rdcTimeout,
-		},
-		Username:  creds.Username,
-		AccessKey: creds.AccessKey,
+		HTTPClient: requesth.NewClient(rdcTimeout),
+		Username:   creds.Username,
+		AccessKey:  creds.AccessKey,
 	}
 
 	as := appstore.New("", creds.Username, creds.AccessKey, appStoreTimeout)
+	as.Cache = appstore.NewCache(appstore.DefaultCachePath())
 
 	if d.Kind == config.KindEspresso && d.APIVersion == config.VersionV1Alpha {
-		return runEspresso(cmd, tc, rs, rc, as)
+		return runEspresso(ctx, cmd, tc, rs, rc, as)
 	}
 
 	return 1, errors.New("unknown framework configuration")
 }
 
-func runEspresso(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, rc rdc.Client, as *appstore.AppStore) (int, error) {
+func runEspresso(ctx context.Context, cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, rc rdc.Client, as *appstore.AppStore) (int, error) {
 	p, err := espresso.FromFile(gFlags.cfgFilePath)
 	if err != nil {
 		return 1, err
 	}
 	p.Sauce.Metadata.ExpandEnv()
 	applyDefaultValues(&p.Sauce)
-	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts)
+	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts, &p.Reporters)
+	if err := configureNetwork(cmd, p.Sauce.Network); err != nil {
+		return 1, err
+	}
+	checkForUpdates()
 	applyEspressoFlags(&p)
 
 	regio := region.FromString(p.Sauce.Region)
@@ -162,6 +167,17 @@ func runEspresso(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, rc
 		}
 	}
 
+	for i, s := range p.Suites {
+		if s.Retries == nil || flagChanged(cmd, "retries") {
+			retries := p.Sauce.Retries
+			s.Retries = &retries
+		}
+		if s.Timeout == 0 {
+			s.Timeout = p.Sauce.SuiteTimeout
+		}
+		p.Suites[i] = s
+	}
+
 	tc.URL = regio.APIBaseURL()
 	rs.URL = regio.APIBaseURL()
 	as.URL = regio.APIBaseURL()
@@ -170,21 +186,26 @@ func runEspresso(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, rc
 	rs.ArtifactConfig = p.Artifacts.Download
 	rc.ArtifactConfig = p.Artifacts.Download
 
-	return runEspressoInCloud(p, regio, tc, rs, rc, as)
+	return runEspressoInCloud(ctx, p, regio, tc, rs, rc, as)
 }
 
-func runEspressoInCloud(p espresso.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, rc rdc.Client, as *appstore.AppStore) (int, error) {
+func runEspressoInCloud(ctx context.Context, p espresso.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, rc rdc.Client, as *appstore.AppStore) (exitCode int, err error) {
 	log.Info().Msg("Running Espresso in Sauce Labs")
 	printTestEnv("sauce")
 
+	reps := createReporters(p.Reporters)
+	defer func() { renderReporters(reps, exitCode) }()
+
 	r := saucecloud.EspressoRunner{
 		Project: p,
 		CloudRunner: saucecloud.CloudRunner{
+			Ctx:                   ctx,
 			ProjectUploader:       as,
 			JobStarter:            &tc,
 			JobReader:             &rs,
 			RDCJobReader:          &rc,
 			JobStopper:            &rs,
+			RDCJobStopper:         &rc,
 			JobWriter:             &tc,
 			CCYReader:             &rs,
 			TunnelService:         &rs,
@@ -192,6 +213,7 @@ func runEspressoInCloud(p espresso.Project, regio region.Region, tc testcomposer
 			ShowConsoleLog:        false,
 			ArtifactDownloader:    &rs,
 			RDCArtifactDownloader: &rc,
+			Reporters:             reps,
 			DryRun:                gFlags.dryRun,
 		},
 	}
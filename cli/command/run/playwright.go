@@ -1,6 +1,7 @@
 package run
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/rs/zerolog/log"
@@ -8,13 +9,15 @@ import (
 	"github.com/saucelabs/saucectl/internal/docker"
 	"github.com/saucelabs/saucectl/internal/playwright"
 	"github.com/saucelabs/saucectl/internal/region"
+	"github.com/saucelabs/saucectl/internal/report"
 	"github.com/saucelabs/saucectl/internal/resto"
 	"github.com/saucelabs/saucectl/internal/saucecloud"
+	"github.com/saucelabs/saucectl/internal/shard"
 	"github.com/saucelabs/saucectl/internal/testcomposer"
 	"github.com/spf13/cobra"
 )
 
-func runPlaywright(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (int, error) {
+func runPlaywright(ctx context.Context, cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (exitCode int, err error) {
 	p, err := playwright.FromFile(gFlags.cfgFilePath)
 	if err != nil {
 		return 1, err
@@ -22,7 +25,11 @@ func runPlaywright(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client,
 
 	p.Sauce.Metadata.ExpandEnv()
 	applyDefaultValues(&p.Sauce)
-	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts)
+	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts, &p.Reporters)
+	if err := configureNetwork(cmd, p.Sauce.Network); err != nil {
+		return 1, err
+	}
+	checkForUpdates()
 
 	// Merge env from CLI args and job config. CLI args take precedence.
 	for k, v := range gFlags.env {
@@ -52,6 +59,13 @@ func runPlaywright(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client,
 		if s.Mode == "" {
 			s.Mode = p.Defaults.Mode
 		}
+		if s.Retries == nil || flagChanged(cmd, "retries") {
+			retries := p.Sauce.Retries
+			s.Retries = &retries
+		}
+		if s.Timeout == 0 {
+			s.Timeout = p.Sauce.SuiteTimeout
+		}
 		p.Suites[i] = s
 	}
 	if gFlags.testEnv != "" {
@@ -73,38 +87,48 @@ func runPlaywright(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client,
 
 	rs.ArtifactConfig = p.Artifacts.Download
 
+	cache := shard.NewCache(shard.DefaultCachePath())
+	if err := playwright.ShardSuites(&p, cache); err != nil {
+		return 1, err
+	}
+
+	// Both halves of a mixed run report to the same reporters, so that they render a single report.
+	reps := append(createReporters(p.Reporters), cache)
+	defer func() { renderReporters(reps, exitCode) }()
+
 	dockerProject, sauceProject := playwright.SplitSuites(p)
 	if len(dockerProject.Suites) != 0 {
-		exitCode, err := runPlaywrightInDocker(dockerProject, tc, rs)
+		exitCode, err := runPlaywrightInDocker(ctx, dockerProject, tc, rs, reps)
 		if err != nil || exitCode != 0 {
 			return exitCode, err
 		}
 	}
 	if len(sauceProject.Suites) != 0 {
-		return runPlaywrightInSauce(sauceProject, regio, tc, rs, as)
+		return runPlaywrightInSauce(ctx, sauceProject, regio, tc, rs, as, reps)
 	}
 
 	return 0, nil
 }
 
-func runPlaywrightInDocker(p playwright.Project, testco testcomposer.Client, rs resto.Client) (int, error) {
+func runPlaywrightInDocker(ctx context.Context, p playwright.Project, testco testcomposer.Client, rs resto.Client, reps []report.Reporter) (int, error) {
 	log.Info().Msg("Running Playwright in Docker")
 	printTestEnv("docker")
 
-	cd, err := docker.NewPlaywright(p, &testco, &testco, &rs)
+	cd, err := docker.NewPlaywright(ctx, p, &testco, &testco, &rs, &rs, reps)
 	if err != nil {
 		return 1, err
 	}
 	return cd.RunProject()
 }
 
-func runPlaywrightInSauce(p playwright.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (int, error) {
+func runPlaywrightInSauce(ctx context.Context, p playwright.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore, reps []report.Reporter) (int, error) {
 	log.Info().Msg("Running Playwright in Sauce Labs")
 	printTestEnv("sauce")
 
 	r := saucecloud.PlaywrightRunner{
 		Project: p,
 		CloudRunner: saucecloud.CloudRunner{
+			Ctx:                ctx,
 			ProjectUploader:    as,
 			JobStarter:         &tc,
 			JobReader:          &rs,
@@ -115,6 +139,7 @@ func runPlaywrightInSauce(p playwright.Project, regio region.Region, tc testcomp
 			Region:             regio,
 			ShowConsoleLog:     p.ShowConsoleLog,
 			ArtifactDownloader: &rs,
+			Reporters:          reps,
 			DryRun:             gFlags.dryRun,
 		},
 	}
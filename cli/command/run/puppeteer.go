@@ -1,6 +1,7 @@
 package run
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/rs/zerolog/log"
@@ -8,18 +9,23 @@ import (
 	"github.com/saucelabs/saucectl/internal/puppeteer"
 	"github.com/saucelabs/saucectl/internal/region"
 	"github.com/saucelabs/saucectl/internal/resto"
+	"github.com/saucelabs/saucectl/internal/shard"
 	"github.com/saucelabs/saucectl/internal/testcomposer"
 	"github.com/spf13/cobra"
 )
 
-func runPuppeteer(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client) (int, error) {
+func runPuppeteer(ctx context.Context, cmd *cobra.Command, tc testcomposer.Client, rs resto.Client) (int, error) {
 	p, err := puppeteer.FromFile(gFlags.cfgFilePath)
 	if err != nil {
 		return 1, err
 	}
 	p.Sauce.Metadata.ExpandEnv()
 	applyDefaultValues(&p.Sauce)
-	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts)
+	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts, &p.Reporters)
+	if err := configureNetwork(cmd, p.Sauce.Network); err != nil {
+		return 1, err
+	}
+	checkForUpdates()
 
 	for k, v := range gFlags.env {
 		for _, s := range p.Suites {
@@ -40,6 +46,17 @@ func runPuppeteer(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client) (
 		}
 	}
 
+	for i, s := range p.Suites {
+		if s.Retries == nil || flagChanged(cmd, "retries") {
+			retries := p.Sauce.Retries
+			s.Retries = &retries
+		}
+		if s.Timeout == 0 {
+			s.Timeout = p.Sauce.SuiteTimeout
+		}
+		p.Suites[i] = s
+	}
+
 	regio := region.FromString(p.Sauce.Region)
 	if regio == region.None {
 		log.Error().Str("region", gFlags.regionFlag).Msg("Unable to determine sauce region.")
@@ -48,14 +65,23 @@ func runPuppeteer(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client) (
 
 	rs.URL = regio.APIBaseURL()
 	tc.URL = regio.APIBaseURL()
-	return runPuppeteerInDocker(p, tc, rs)
+
+	cache := shard.NewCache(shard.DefaultCachePath())
+	if err := puppeteer.ShardSuites(&p, cache); err != nil {
+		return 1, err
+	}
+
+	return runPuppeteerInDocker(ctx, p, tc, rs, cache)
 }
 
-func runPuppeteerInDocker(p puppeteer.Project, testco testcomposer.Client, rs resto.Client) (int, error) {
+func runPuppeteerInDocker(ctx context.Context, p puppeteer.Project, testco testcomposer.Client, rs resto.Client, cache *shard.Cache) (exitCode int, err error) {
 	log.Info().Msg("Running puppeteer in Docker")
 	printTestEnv("docker")
 
-	cd, err := docker.NewPuppeteer(p, &testco, &testco, &rs)
+	reps := append(createReporters(p.Reporters), cache)
+	defer func() { renderReporters(reps, exitCode) }()
+
+	cd, err := docker.NewPuppeteer(ctx, p, &testco, &testco, &rs, &rs, reps)
 	if err != nil {
 		return 1, err
 	}
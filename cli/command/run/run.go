@@ -1,12 +1,13 @@
 package run
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
-	"runtime"
 	"syscall"
 	"time"
 
@@ -22,6 +23,11 @@ import (
 	"github.com/saucelabs/saucectl/internal/github"
 	"github.com/saucelabs/saucectl/internal/msg"
 	"github.com/saucelabs/saucectl/internal/rdc"
+	"github.com/saucelabs/saucectl/internal/report"
+	"github.com/saucelabs/saucectl/internal/report/json"
+	"github.com/saucelabs/saucectl/internal/report/junit"
+	"github.com/saucelabs/saucectl/internal/report/table"
+	"github.com/saucelabs/saucectl/internal/requesth"
 	"github.com/saucelabs/saucectl/internal/resto"
 	"github.com/saucelabs/saucectl/internal/sentry"
 	"github.com/saucelabs/saucectl/internal/testcomposer"
@@ -34,6 +40,8 @@ var (
 	defaultLogFir      = "<cwd>/logs"
 	defaultRegion      = "us-west-1"
 	defaultSauceignore = ".sauceignore"
+	defaultJUnitReport = "saucectl-report.xml"
+	defaultJSONReport  = "saucectl-report.json"
 
 	// General Request Timeouts
 	appStoreTimeout     = 300 * time.Second
@@ -47,32 +55,46 @@ var (
 var gFlags = globalFlags{}
 
 type globalFlags struct {
-	cfgFilePath    string
-	cfgLogDir      string
-	globalTimeout  time.Duration
-	regionFlag     string
-	env            map[string]string
-	sauceAPI       string
-	suiteName      string
-	testEnvSilent  bool
-	testEnv        string
-	showConsoleLog bool
-	concurrency    int
-	tunnelID       string
-	tunnelParent   string
-	runnerVersion  string
-	sauceignore    string
-	experiments    map[string]string
-	dryRun         bool
-	tags           []string
-	build          string
-	artifacts      struct {
+	cfgFilePath      string
+	cfgLogDir        string
+	globalTimeout    time.Duration
+	regionFlag       string
+	env              map[string]string
+	sauceAPI         string
+	suiteName        string
+	testEnvSilent    bool
+	testEnv          string
+	showConsoleLog   bool
+	concurrency      int
+	retries          int
+	suiteTimeout     time.Duration
+	tunnelID         string
+	tunnelParent     string
+	runnerVersion    string
+	sauceignore      string
+	useGitignore     bool
+	experiments      map[string]string
+	dryRun           bool
+	allowUnknownKeys bool
+	tags             []string
+	build            string
+	artifacts        struct {
 		download struct {
 			when      string
 			match     []string
 			directory string
 		}
 	}
+	reporters struct {
+		junit struct {
+			enabled  bool
+			filename string
+		}
+		json struct {
+			enabled  bool
+			filename string
+		}
+	}
 }
 
 // Command creates the `run` command
@@ -106,12 +128,16 @@ func Command(cli *command.SauceCtlCli) *cobra.Command {
 	cmd.PersistentFlags().StringVar(&gFlags.testEnv, "test-env", "", "Specifies the environment in which the tests should run. Choice: docker|sauce.")
 	cmd.PersistentFlags().BoolVarP(&gFlags.showConsoleLog, "show-console-log", "", false, "Shows suites console.log locally. By default console.log is only shown on failures.")
 	cmd.PersistentFlags().IntVar(&gFlags.concurrency, "ccy", 2, "Concurrency specifies how many suites are run at the same time.")
+	cmd.PersistentFlags().IntVar(&gFlags.retries, "retries", 0, "Retries specifies how often a failed suite is re-run before it is reported as failed. Overrides the retries of every suite.")
+	cmd.PersistentFlags().DurationVar(&gFlags.suiteTimeout, "suite-timeout", 0, "Limits how long each suite can run before it is stopped and reported as timed out, e.g. '15m'. (default: no timeout)")
 	cmd.PersistentFlags().StringVar(&gFlags.tunnelID, "tunnel-id", "", "Sets the sauce-connect tunnel ID to be used for the run.")
 	cmd.PersistentFlags().StringVar(&gFlags.tunnelParent, "tunnel-parent", "", "Sets the sauce-connect tunnel parent to be used for the run.")
 	cmd.PersistentFlags().StringVar(&gFlags.runnerVersion, "runner-version", "", "Overrides the automatically determined runner version.")
 	cmd.PersistentFlags().StringVar(&gFlags.sauceignore, "sauceignore", "", "Specifies the path to the .sauceignore file.")
+	cmd.PersistentFlags().BoolVar(&gFlags.useGitignore, "use-gitignore", false, "Excludes files listed in .gitignore files from the bundle as well.")
 	cmd.PersistentFlags().StringToStringVar(&gFlags.experiments, "experiment", map[string]string{}, "Specifies a list of experimental flags and values")
 	cmd.PersistentFlags().BoolVarP(&gFlags.dryRun, "dry-run", "", false, "Simulate a test run without actually running any tests.")
+	cmd.PersistentFlags().BoolVar(&gFlags.allowUnknownKeys, "allow-unknown-keys", false, "Ignores keys in the config file that saucectl does not know about, rather than rejecting them.")
 
 	// Metadata
 	cmd.PersistentFlags().StringSliceVar(&gFlags.tags, "tags", []string{}, "Adds tags to tests")
@@ -122,6 +148,12 @@ func Command(cli *command.SauceCtlCli) *cobra.Command {
 	cmd.PersistentFlags().StringSliceVar(&gFlags.artifacts.download.match, "artifacts.download.match", []string{}, "Specifies which test artifacts to download")
 	cmd.PersistentFlags().StringVar(&gFlags.artifacts.download.directory, "artifacts.download.directory", "", "Specifies the location where to download test artifacts to")
 
+	// Reporters
+	cmd.PersistentFlags().BoolVar(&gFlags.reporters.junit.enabled, "reporters.junit.enabled", false, "Enables the junit reporter, which merges the junit reports of all suites into a single file")
+	cmd.PersistentFlags().StringVar(&gFlags.reporters.junit.filename, "reporters.junit.filename", "", "Specifies the file name of the junit report (default: "+defaultJUnitReport+")")
+	cmd.PersistentFlags().BoolVar(&gFlags.reporters.json.enabled, "reporters.json.enabled", false, "Enables the json reporter, which writes a summary of the run to a file")
+	cmd.PersistentFlags().StringVar(&gFlags.reporters.json.filename, "reporters.json.filename", "", "Specifies the file name of the json report (default: "+defaultJSONReport+")")
+
 	cmd.Flags().MarkDeprecated("test-env", "please set mode in config file")
 
 	// Hide undocumented flags that the user does not need to care about.
@@ -137,8 +169,8 @@ func Command(cli *command.SauceCtlCli) *cobra.Command {
 // Run runs the command
 func Run(cmd *cobra.Command, cli *command.SauceCtlCli, args []string) (int, error) {
 	println("Running version", version.Version)
-	checkForUpdates()
-	go awaitGlobalTimeout()
+	ctx, cancel := newRunContext(gFlags.globalTimeout)
+	defer cancel()
 
 	creds := credentials.Get()
 	if !creds.IsValid() {
@@ -155,6 +187,7 @@ func Run(cmd *cobra.Command, cli *command.SauceCtlCli, args []string) (int, erro
 	}
 	cli.LogDir = gFlags.cfgLogDir
 	log.Info().Str("config", gFlags.cfgFilePath).Msg("Reading config file")
+	config.AllowUnknownKeys = gFlags.allowUnknownKeys
 
 	d, err := config.Describe(gFlags.cfgFilePath)
 	if err != nil {
@@ -162,46 +195,45 @@ func Run(cmd *cobra.Command, cli *command.SauceCtlCli, args []string) (int, erro
 	}
 
 	tc := testcomposer.Client{
-		HTTPClient:  &http.Client{Timeout: testComposerTimeout},
+		HTTPClient:  requesth.NewClient(testComposerTimeout),
 		URL:         "", // updated later once region is determined
 		Credentials: creds,
 	}
 
 	rs := resto.Client{
-		HTTPClient: &http.Client{Timeout: restoTimeout},
+		HTTPClient: requesth.NewClient(restoTimeout),
 		URL:        "", // updated later once region is determined
 		Username:   creds.Username,
 		AccessKey:  creds.AccessKey,
 	}
 
 	rc := rdc.Client{
-		HTTPClient: &http.Client{
-			Timeout: rdcTimeout,
-		},
-		Username:  creds.Username,
-		AccessKey: creds.AccessKey,
+		HTTPClient: requesth.NewClient(rdcTimeout),
+		Username:   creds.Username,
+		AccessKey:  creds.AccessKey,
 	}
 
 	as := appstore.New("", creds.Username, creds.AccessKey, appStoreTimeout)
+	as.Cache = appstore.NewCache(appstore.DefaultCachePath())
 
 	// TODO switch statement with pre-constructed type definition structs?
 	if d.Kind == config.KindCypress && d.APIVersion == config.VersionV1Alpha {
-		return runCypress(cmd, tc, rs, as)
+		return runCypress(ctx, cmd, tc, rs, as)
 	}
 	if d.Kind == config.KindPlaywright && d.APIVersion == config.VersionV1Alpha {
-		return runPlaywright(cmd, tc, rs, as)
+		return runPlaywright(ctx, cmd, tc, rs, as)
 	}
 	if d.Kind == config.KindTestcafe && d.APIVersion == config.VersionV1Alpha {
-		return runTestcafe(cmd, tc, rs, as)
+		return runTestcafe(ctx, cmd, tc, rs, as)
 	}
 	if d.Kind == config.KindPuppeteer && d.APIVersion == config.VersionV1Alpha {
-		return runPuppeteer(cmd, tc, rs)
+		return runPuppeteer(ctx, cmd, tc, rs)
 	}
 	if d.Kind == config.KindEspresso && d.APIVersion == config.VersionV1Alpha {
-		return runEspresso(cmd, tc, rs, rc, as)
+		return runEspresso(ctx, cmd, tc, rs, rc, as)
 	}
 	if d.Kind == config.KindXcuitest && d.APIVersion == config.VersionV1Alpha {
-		return runXcuitest(cmd, tc, rs, rc, as)
+		return runXcuitest(ctx, cmd, tc, rs, rc, as)
 	}
 
 	return 1, errors.New("unknown framework configuration")
@@ -214,9 +246,9 @@ func printTestEnv(testEnv string) {
 
 	switch testEnv {
 	case "docker":
-		fmt.Println(msg.DockerLogo)
+		fmt.Printf("%s\n", msg.DockerLogo)
 	case "sauce":
-		fmt.Println(msg.SauceLogo)
+		fmt.Printf("%s\n", msg.SauceLogo)
 	}
 }
 
@@ -230,13 +262,44 @@ func applyDefaultValues(sauce *config.SauceConfig) {
 	}
 }
 
-func overrideCliParameters(cmd *cobra.Command, sauce *config.SauceConfig, arti *config.Artifacts) {
+// configureNetwork applies the network settings of the config file, unless they are overridden by flags.
+func configureNetwork(cmd *cobra.Command, network config.Network) error {
+	if network == (config.Network{}) {
+		return nil
+	}
+
+	opts := requesth.Options()
+	if network.Proxy != "" && !flagChanged(cmd, "proxy") {
+		opts.Proxy = network.Proxy
+	}
+	if network.CABundle != "" && !flagChanged(cmd, "ca-bundle") {
+		opts.CABundle = network.CABundle
+	}
+	if network.Insecure && !flagChanged(cmd, "insecure") {
+		opts.Insecure = true
+	}
+	return requesth.Configure(opts)
+}
+
+// flagChanged returns whether the flag with the given name, which may be inherited from a parent command, is set.
+func flagChanged(cmd *cobra.Command, name string) bool {
+	f := cmd.Flags().Lookup(name)
+	return f != nil && f.Changed
+}
+
+func overrideCliParameters(cmd *cobra.Command, sauce *config.SauceConfig, arti *config.Artifacts, reps *config.Reporters) {
 	if cmd.Flags().Lookup("region").Changed {
 		sauce.Region = gFlags.regionFlag
 	}
 	if cmd.Flags().Lookup("ccy").Changed {
 		sauce.Concurrency = gFlags.concurrency
 	}
+	if cmd.Flags().Lookup("retries").Changed {
+		sauce.Retries = gFlags.retries
+	}
+	if cmd.Flags().Lookup("suite-timeout").Changed {
+		sauce.SuiteTimeout = gFlags.suiteTimeout
+	}
 	if cmd.Flags().Lookup("tunnel-id").Changed {
 		sauce.Tunnel.ID = gFlags.tunnelID
 	}
@@ -246,6 +309,9 @@ func overrideCliParameters(cmd *cobra.Command, sauce *config.SauceConfig, arti *
 	if cmd.Flags().Lookup("sauceignore").Changed {
 		sauce.Sauceignore = gFlags.sauceignore
 	}
+	if cmd.Flags().Lookup("use-gitignore").Changed {
+		sauce.UseGitignore = gFlags.useGitignore
+	}
 	if cmd.Flags().Lookup("experiment").Changed {
 		sauce.Experiments = gFlags.experiments
 	}
@@ -264,40 +330,103 @@ func overrideCliParameters(cmd *cobra.Command, sauce *config.SauceConfig, arti *
 	if gFlags.artifacts.download.directory != "" {
 		arti.Download.Directory = gFlags.artifacts.download.directory
 	}
+	if cmd.Flags().Lookup("reporters.junit.enabled").Changed {
+		reps.JUnit.Enabled = gFlags.reporters.junit.enabled
+	}
+	if gFlags.reporters.junit.filename != "" {
+		reps.JUnit.Filename = gFlags.reporters.junit.filename
+	}
+	if cmd.Flags().Lookup("reporters.json.enabled").Changed {
+		reps.JSON.Enabled = gFlags.reporters.json.enabled
+	}
+	if gFlags.reporters.json.filename != "" {
+		reps.JSON.Filename = gFlags.reporters.json.filename
+	}
 }
 
-// awaitGlobalTimeout waits for the global timeout event. In case of global timeout event, it attempts to interrupt the
-// current process. Should this fail, a hard immediate exit is performed.
-func awaitGlobalTimeout() {
-	if gFlags.globalTimeout == 0 {
-		return
+// createReporters creates the reporters that are enabled in the given config. The table reporter is always present.
+func createReporters(c config.Reporters) []report.Reporter {
+	reps := []report.Reporter{&table.Reporter{Dst: os.Stdout}}
+
+	if c.JUnit.Enabled {
+		filename := c.JUnit.Filename
+		if filename == "" {
+			filename = defaultJUnitReport
+		}
+		reps = append(reps, &junit.Reporter{Filename: filename})
 	}
 
-	<-time.After(gFlags.globalTimeout)
-	msg.LogGlobalTimeoutShutdown()
+	if c.JSON.Enabled {
+		filename := c.JSON.Filename
+		if filename == "" {
+			filename = defaultJSONReport
+		}
+		reps = append(reps, &json.Reporter{Filename: filename})
+	}
 
-	// A timeout for soft shutdown.
-	go func() {
-		<-time.After(10 * time.Second)
-		color.Red("Unable to perform soft shutdown. Exiting immediately...")
-		os.Exit(1)
-	}()
+	return reps
+}
 
-	// Can't send interrupt signals on windows. A hard exit is our only choice.
-	if runtime.GOOS == "windows" {
-		os.Exit(1)
+// renderReporters renders the reporters of a run once all of its suites are done. exitCode is the code that saucectl
+// exits with, which may differ from the results of the suites, e.g. if the project failed to upload.
+func renderReporters(reps []report.Reporter, exitCode int) {
+	for _, r := range reps {
+		if jr, ok := r.(*json.Reporter); ok {
+			jr.ExitCode = exitCode
+		}
+		r.Render()
 	}
+}
+
+// newRunContext returns the context of a test run, which is cancelled on the first interrupt or termination signal and
+// once the global timeout, if any, has passed. Cancelling it skips the suites that haven't started yet and stops those
+// in progress. A second signal exits immediately. The returned cancel function must be called once the run is over.
+func newRunContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, os.Interrupt, syscall.SIGTERM)
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-sigC:
+			log.Info().Msg("Ctrl-C captured. Ctrl-C again to exit now.")
+			cancel()
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				msg.LogGlobalTimeoutShutdown()
+			}
+		case <-done:
+			return
+		}
+
+		select {
+		case <-sigC:
+			os.Exit(1)
+		case <-done:
+		}
+	}()
 
-	p, err := os.FindProcess(os.Getpid())
-	if err == nil {
-		_ = p.Signal(syscall.SIGINT)
+	return ctx, func() {
+		signal.Stop(sigC)
+		close(done)
+		cancel()
 	}
 }
 
-// checkForUpdates check if there is a saucectl update available.
+// checkForUpdates check if there is a saucectl update available. It's called once the network settings of the config
+// file are applied, so that the check uses the same proxy as all other requests.
 func checkForUpdates() {
+	// The check is best-effort, so it doesn't retry failed requests like the Sauce Labs clients do.
 	gh := github.Client{
-		HTTPClient: &http.Client{Timeout: githubTimeout},
+		HTTPClient: &http.Client{Timeout: githubTimeout, Transport: requesth.ConfiguredTransport{}},
 		URL:        "https://api.github.com",
 	}
 
@@ -1,9 +1,13 @@
 package run
 
 import (
+	"context"
 	"github.com/saucelabs/saucectl/internal/espresso"
+	"os"
 	"reflect"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/cypress"
@@ -226,3 +230,45 @@ func TestFilterEspressoSuite(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRunContext_Timeout(t *testing.T) {
+	ctx, cancel := newRunContext(10 * time.Millisecond)
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context not done after timeout")
+	}
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+}
+
+func TestNewRunContext_Signal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("interrupt signals cannot be sent on windows")
+	}
+	ctx, cancel := newRunContext(0)
+	defer cancel()
+
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Signal(os.Interrupt); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context not done after interrupt")
+	}
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+func TestNewRunContext_Cancel(t *testing.T) {
+	ctx, cancel := newRunContext(time.Hour)
+	cancel()
+
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
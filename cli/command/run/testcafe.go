@@ -1,20 +1,23 @@
 package run
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/rs/zerolog/log"
 	"github.com/saucelabs/saucectl/internal/appstore"
 	"github.com/saucelabs/saucectl/internal/docker"
 	"github.com/saucelabs/saucectl/internal/region"
+	"github.com/saucelabs/saucectl/internal/report"
 	"github.com/saucelabs/saucectl/internal/resto"
 	"github.com/saucelabs/saucectl/internal/saucecloud"
+	"github.com/saucelabs/saucectl/internal/shard"
 	"github.com/saucelabs/saucectl/internal/testcafe"
 	"github.com/saucelabs/saucectl/internal/testcomposer"
 	"github.com/spf13/cobra"
 )
 
-func runTestcafe(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (int, error) {
+func runTestcafe(ctx context.Context, cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (exitCode int, err error) {
 	p, err := testcafe.FromFile(gFlags.cfgFilePath)
 	if err != nil {
 		return 1, err
@@ -22,7 +25,11 @@ func runTestcafe(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as
 
 	p.Sauce.Metadata.ExpandEnv()
 	applyDefaultValues(&p.Sauce)
-	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts)
+	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts, &p.Reporters)
+	if err := configureNetwork(cmd, p.Sauce.Network); err != nil {
+		return 1, err
+	}
+	checkForUpdates()
 
 	for k, v := range gFlags.env {
 		for _, s := range p.Suites {
@@ -51,6 +58,13 @@ func runTestcafe(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as
 		if s.Mode == "" {
 			s.Mode = p.Defaults.Mode
 		}
+		if s.Retries == nil || flagChanged(cmd, "retries") {
+			retries := p.Sauce.Retries
+			s.Retries = &retries
+		}
+		if s.Timeout == 0 {
+			s.Timeout = p.Sauce.SuiteTimeout
+		}
 		p.Suites[i] = s
 	}
 	if gFlags.testEnv != "" {
@@ -72,38 +86,48 @@ func runTestcafe(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, as
 
 	rs.ArtifactConfig = p.Artifacts.Download
 
+	cache := shard.NewCache(shard.DefaultCachePath())
+	if err := testcafe.ShardSuites(&p, cache); err != nil {
+		return 1, err
+	}
+
+	// Both halves of a mixed run report to the same reporters, so that they render a single report.
+	reps := append(createReporters(p.Reporters), cache)
+	defer func() { renderReporters(reps, exitCode) }()
+
 	dockerProject, sauceProject := testcafe.SplitSuites(p)
 	if len(dockerProject.Suites) != 0 {
-		exitCode, err := runTestcafeInDocker(dockerProject, tc, rs)
+		exitCode, err := runTestcafeInDocker(ctx, dockerProject, tc, rs, reps)
 		if err != nil || exitCode != 0 {
 			return exitCode, err
 		}
 	}
 	if len(sauceProject.Suites) != 0 {
-		return runTestcafeInCloud(sauceProject, regio, tc, rs, as)
+		return runTestcafeInCloud(ctx, sauceProject, regio, tc, rs, as, reps)
 	}
 
 	return 0, nil
 }
 
-func runTestcafeInDocker(p testcafe.Project, testco testcomposer.Client, rs resto.Client) (int, error) {
+func runTestcafeInDocker(ctx context.Context, p testcafe.Project, testco testcomposer.Client, rs resto.Client, reps []report.Reporter) (int, error) {
 	log.Info().Msg("Running Testcafe in Docker")
 	printTestEnv("docker")
 
-	cd, err := docker.NewTestcafe(p, &testco, &testco, &rs)
+	cd, err := docker.NewTestcafe(ctx, p, &testco, &testco, &rs, &rs, reps)
 	if err != nil {
 		return 1, err
 	}
 	return cd.RunProject()
 }
 
-func runTestcafeInCloud(p testcafe.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore) (int, error) {
+func runTestcafeInCloud(ctx context.Context, p testcafe.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, as *appstore.AppStore, reps []report.Reporter) (int, error) {
 	log.Info().Msg("Running Testcafe in Sauce Labs")
 	printTestEnv("sauce")
 
 	r := saucecloud.TestcafeRunner{
 		Project: p,
 		CloudRunner: saucecloud.CloudRunner{
+			Ctx:                ctx,
 			ProjectUploader:    as,
 			JobStarter:         &tc,
 			JobReader:          &rs,
@@ -114,6 +138,7 @@ func runTestcafeInCloud(p testcafe.Project, regio region.Region, tc testcomposer
 			Region:             regio,
 			ShowConsoleLog:     p.ShowConsoleLog,
 			ArtifactDownloader: &rs,
+			Reporters:          reps,
 			DryRun:             gFlags.dryRun,
 		},
 	}
@@ -1,6 +1,7 @@
 package run
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/rs/zerolog/log"
@@ -14,14 +15,18 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func runXcuitest(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, rc rdc.Client, as *appstore.AppStore) (int, error) {
+func runXcuitest(ctx context.Context, cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, rc rdc.Client, as *appstore.AppStore) (int, error) {
 	p, err := xcuitest.FromFile(gFlags.cfgFilePath)
 	if err != nil {
 		return 1, err
 	}
 	p.Sauce.Metadata.ExpandEnv()
 	applyDefaultValues(&p.Sauce)
-	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts)
+	overrideCliParameters(cmd, &p.Sauce, &p.Artifacts, &p.Reporters)
+	if err := configureNetwork(cmd, p.Sauce.Network); err != nil {
+		return 1, err
+	}
+	checkForUpdates()
 
 	regio := region.FromString(p.Sauce.Region)
 	if regio == region.None {
@@ -41,6 +46,17 @@ func runXcuitest(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, rc
 		}
 	}
 
+	for i, s := range p.Suites {
+		if s.Retries == nil || flagChanged(cmd, "retries") {
+			retries := p.Sauce.Retries
+			s.Retries = &retries
+		}
+		if s.Timeout == 0 {
+			s.Timeout = p.Sauce.SuiteTimeout
+		}
+		p.Suites[i] = s
+	}
+
 	tc.URL = regio.APIBaseURL()
 	rs.URL = regio.APIBaseURL()
 	as.URL = regio.APIBaseURL()
@@ -49,21 +65,26 @@ func runXcuitest(cmd *cobra.Command, tc testcomposer.Client, rs resto.Client, rc
 	rs.ArtifactConfig = p.Artifacts.Download
 	rc.ArtifactConfig = p.Artifacts.Download
 
-	return runXcuitestInCloud(p, regio, tc, rs, rc, as)
+	return runXcuitestInCloud(ctx, p, regio, tc, rs, rc, as)
 }
 
-func runXcuitestInCloud(p xcuitest.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, rc rdc.Client, as *appstore.AppStore) (int, error) {
+func runXcuitestInCloud(ctx context.Context, p xcuitest.Project, regio region.Region, tc testcomposer.Client, rs resto.Client, rc rdc.Client, as *appstore.AppStore) (exitCode int, err error) {
 	log.Info().Msg("Running XCUITest in Sauce Labs")
 	printTestEnv("sauce")
 
+	reps := createReporters(p.Reporters)
+	defer func() { renderReporters(reps, exitCode) }()
+
 	r := saucecloud.XcuitestRunner{
 		Project: p,
 		CloudRunner: saucecloud.CloudRunner{
+			Ctx:                   ctx,
 			ProjectUploader:       as,
 			JobStarter:            &tc,
 			JobReader:             &rs,
 			RDCJobReader:          &rc,
 			JobStopper:            &rs,
+			RDCJobStopper:         &rc,
 			JobWriter:             &tc,
 			CCYReader:             &rs,
 			TunnelService:         &rs,
@@ -71,6 +92,7 @@ func runXcuitestInCloud(p xcuitest.Project, regio region.Region, tc testcomposer
 			ShowConsoleLog:        false,
 			ArtifactDownloader:    &rs,
 			RDCArtifactDownloader: &rc,
+			Reporters:             reps,
 			DryRun:                gFlags.dryRun,
 		},
 	}
@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/cli/command"
+	"github.com/saucelabs/saucectl/internal/appstore"
+	"github.com/saucelabs/saucectl/internal/credentials"
+	"github.com/saucelabs/saucectl/internal/region"
+	"github.com/saucelabs/saucectl/internal/sentry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	storageUse   = "storage"
+	storageShort = "Manage apps and bundles in Sauce Labs storage"
+	storageLong  = `Manage the apps and bundles in Sauce Labs storage. Uploaded files can be referenced in a config via 'storage:<id>'.`
+
+	requestTimeout = 5 * time.Minute
+)
+
+// flags contains all flags that are shared by the storage subcommands.
+var flags = struct {
+	region string
+	json   bool
+}{}
+
+// Command creates the `storage` command
+func Command(cli *command.SauceCtlCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   storageUse,
+		Short: storageShort,
+		Long:  storageLong,
+	}
+
+	cmd.PersistentFlags().StringVarP(&flags.region, "region", "r", region.USWest1.String(), "The sauce labs region.")
+	cmd.PersistentFlags().BoolVar(&flags.json, "json", false, "Prints the output as json.")
+
+	cmd.AddCommand(
+		ListCommand(),
+		UploadCommand(),
+		DeleteCommand(),
+		FindCommand(),
+	)
+	return cmd
+}
+
+// newAppStore returns a client for the storage of the region selected by flags.
+func newAppStore() (*appstore.AppStore, error) {
+	creds := credentials.Get()
+	if !creds.IsValid() {
+		return nil, errors.New("no credentials set, run 'saucectl configure' first")
+	}
+
+	regio := region.FromString(flags.region)
+	if regio == region.None {
+		return nil, fmt.Errorf("unknown region '%s'", flags.region)
+	}
+
+	as := appstore.New(regio.APIBaseURL(), creds.Username, creds.AccessKey, requestTimeout)
+	as.Cache = appstore.NewCache(appstore.DefaultCachePath())
+	return as, nil
+}
+
+// run executes fn and terminates saucectl if it fails.
+func run(name string, fn func() error) {
+	if err := fn(); err != nil {
+		log.Err(err).Msgf("failed to execute %s command", name)
+		sentry.CaptureError(err, sentry.Scope{})
+		os.Exit(1)
+	}
+}
+
+func printJSON(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
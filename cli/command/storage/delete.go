@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// DeleteCommand creates the `storage delete` command
+func DeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete <id>...",
+		Short:   "Delete one or more files from storage",
+		Example: "saucectl storage delete 4f4fe8d2-d3a1-4b6e-a2b3-8c1f4d3e5a6b",
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			run("storage delete", func() error {
+				return Delete(args)
+			})
+		},
+	}
+}
+
+// Delete deletes the files with the given ids. All files are attempted to be deleted, even if deleting one of them
+// fails.
+func Delete(ids []string) error {
+	as, err := newAppStore()
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, id := range ids {
+		if err := as.Delete(id); err != nil {
+			log.Error().Err(err).Str("id", id).Msg("Failed to delete file.")
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		log.Info().Str("id", id).Msg("Deleted file.")
+	}
+	return firstErr
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// FindCommand creates the `storage find` command
+func FindCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "find <file>",
+		Short:   "Find a file in storage that is identical to a local one",
+		Long:    "Find a file in storage that is identical to a local one, based on its MD5 checksum.",
+		Example: "saucectl storage find ./app-debug.apk",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			run("storage find", func() error {
+				return Find(args[0])
+			})
+		},
+	}
+}
+
+// Find prints the storage ID of the file that is identical to filename.
+func Find(filename string) error {
+	as, err := newAppStore()
+	if err != nil {
+		return err
+	}
+
+	meta, err := as.Find(context.Background(), filename)
+	if err != nil {
+		return err
+	}
+	if meta.ID == "" {
+		return fmt.Errorf("no file in storage matches %s", filename)
+	}
+	if flags.json {
+		return printJSON(map[string]string{"id": meta.ID})
+	}
+	log.Info().Str("id", meta.ID).Msg("Found file in storage.")
+	return nil
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/saucelabs/saucectl/internal/appstore"
+	"github.com/spf13/cobra"
+)
+
+var listFlags = struct {
+	name  string
+	kind  string
+	since string
+	until string
+}{}
+
+// ListCommand creates the `storage list` command
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List files in storage",
+		Example: "saucectl storage list --kind android --since 2021-05-01",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			run("storage list", List)
+		},
+	}
+	cmd.Flags().StringVar(&listFlags.name, "name", "", "Only lists files whose name matches.")
+	cmd.Flags().StringVar(&listFlags.kind, "kind", "", "Only lists files of the given kind. Choice: android|ios|other.")
+	cmd.Flags().StringVar(&listFlags.since, "since", "", "Only lists files uploaded since the given date (e.g. 2021-05-01) or duration (e.g. 24h).")
+	cmd.Flags().StringVar(&listFlags.until, "until", "", "Only lists files uploaded until the given date (e.g. 2021-05-31) or duration (e.g. 24h).")
+	return cmd
+}
+
+// List prints all files in storage that match the filters set by flags.
+func List() error {
+	since, err := parseTime(listFlags.since, time.Now())
+	if err != nil {
+		return fmt.Errorf("invalid --since: %v", err)
+	}
+	until, err := parseTime(listFlags.until, time.Now())
+	if err != nil {
+		return fmt.Errorf("invalid --until: %v", err)
+	}
+
+	as, err := newAppStore()
+	if err != nil {
+		return err
+	}
+
+	items, err := as.List(appstore.ListOptions{Query: listFlags.name, Kind: listFlags.kind, Since: since, Until: until})
+	if err != nil {
+		return err
+	}
+	if flags.json {
+		return printJSON(items)
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(table.StyleLight)
+	t.AppendHeader(table.Row{"ID", "Name", "Kind", "Size", "Uploaded"})
+	for _, it := range items {
+		t.AppendRow(table.Row{it.ID, it.Name, it.Kind, it.Size,
+			time.Unix(it.UploadTimestamp, 0).Format(time.RFC3339)})
+	}
+	t.Render()
+	return nil
+}
+
+// parseTime parses s as either a date, a timestamp or a duration that is subtracted from now. An empty s results in
+// the zero time.
+func parseTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", s, time.Local)
+}
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2021, 5, 20, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "", want: time.Time{}},
+		{in: "24h", want: now.Add(-24 * time.Hour)},
+		{in: "2021-05-01T10:00:00Z", want: time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)},
+		{in: "2021-05-01", want: time.Date(2021, 5, 1, 0, 0, 0, 0, time.Local)},
+		{in: "yesterday", wantErr: true},
+	}
+	for _, tt := range testCases {
+		got, err := parseTime(tt.in, now)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.True(t, tt.want.Equal(got), "%s: want %v, got %v", tt.in, tt.want, got)
+	}
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// UploadCommand creates the `storage upload` command
+func UploadCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "upload <file>",
+		Short:   "Upload a file to storage",
+		Example: "saucectl storage upload ./app-debug.apk",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			run("storage upload", func() error {
+				return Upload(args[0])
+			})
+		},
+	}
+}
+
+// Upload uploads the file at filename and prints its storage ID.
+func Upload(filename string) error {
+	as, err := newAppStore()
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("file", filename).Msg("Uploading file.")
+	meta, err := as.Upload(context.Background(), filename)
+	if err != nil {
+		return err
+	}
+	if flags.json {
+		return printJSON(map[string]string{"id": meta.ID})
+	}
+	log.Info().Str("id", meta.ID).Msgf("Uploaded file. Reference it in your config as 'storage:%s'.", meta.ID)
+	return nil
+}
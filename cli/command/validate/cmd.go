@@ -0,0 +1,282 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/cli/command"
+	"github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/cypress"
+	"github.com/saucelabs/saucectl/internal/espresso"
+	"github.com/saucelabs/saucectl/internal/playwright"
+	"github.com/saucelabs/saucectl/internal/puppeteer"
+	"github.com/saucelabs/saucectl/internal/schema"
+	"github.com/saucelabs/saucectl/internal/testcafe"
+	"github.com/saucelabs/saucectl/internal/xcuitest"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	validateUse   = "validate"
+	validateShort = "Validate a saucectl config file"
+	validateLong  = `Validate a saucectl config file without running any tests. No Sauce Labs credentials are required.
+All structural problems are reported at once, along with their position in the config file. Only then are the
+framework specific checks of the run command performed, which stop at the first problem.
+
+Use --schema to print the JSON Schema of a framework's config, which IDEs use for validation and autocompletion.`
+	validateExample = `saucectl validate -c .sauce/config.yml
+saucectl validate --schema cypress > .sauce/cypress.schema.json`
+
+	cfgFilePath = ""
+	schemaKind  = ""
+)
+
+// projects maps each config kind to the structure of its project config.
+var projects = map[string]interface{}{
+	config.KindCypress:    cypress.Project{},
+	config.KindPlaywright: playwright.Project{},
+	config.KindPuppeteer:  puppeteer.Project{},
+	config.KindTestcafe:   testcafe.Project{},
+	config.KindEspresso:   espresso.Project{},
+	config.KindXcuitest:   xcuitest.Project{},
+}
+
+// Command creates the `validate` command
+func Command(cli *command.SauceCtlCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     validateUse,
+		Short:   validateShort,
+		Long:    validateLong,
+		Example: validateExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			var err error
+			if schemaKind != "" {
+				err = PrintSchema(schemaKind)
+			} else {
+				err = Run(cfgFilePath)
+			}
+			// An invalid config is the user's to fix, rather than a crash to report, so there's no call to sentry.
+			if err != nil {
+				log.Err(err).Msg("failed to execute validate command")
+				os.Exit(1)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&cfgFilePath, "config", "c", ".sauce/config.yml", "config file to validate")
+	cmd.Flags().StringVar(&schemaKind, "schema", "",
+		fmt.Sprintf("print the JSON Schema of the given kind of config instead (%s)", strings.Join(kinds(), "|")))
+	return cmd
+}
+
+// Run validates the config file at cfgPath and prints every problem that was found.
+func Run(cfgPath string) error {
+	raw, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return fmt.Errorf("failed to locate project configuration: %v", err)
+	}
+	b, err := config.Resolve(cfgPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve project configuration: %v", err)
+	}
+
+	pp := Problems(cfgPath, b)
+	if len(pp) == 0 {
+		log.Info().Str("config", cfgPath).Msg("Configuration is valid.")
+		return nil
+	}
+
+	if !bytes.Equal(raw, b) {
+		fmt.Printf("%s references other config files, positions refer to the output of 'saucectl config print'.\n", cfgPath)
+	}
+
+	for _, p := range pp {
+		if p.Line > 0 {
+			fmt.Printf("%s:%d:%d: %s\n", cfgPath, p.Line, p.Column, p)
+			continue
+		}
+		fmt.Printf("%s: %s\n", cfgPath, p)
+	}
+	return fmt.Errorf("found %d problem(s) in %s", len(pp), cfgPath)
+}
+
+// Problems returns all problems of the config file cfgPath, whose content is b. Structural problems are reported with
+// their position in the file. Only if there are none, are the framework specific checks performed. These are shared
+// with the run command and return the first problem they find as an error, so at most one such problem is reported,
+// without any position.
+func Problems(cfgPath string, b []byte) []schema.Problem {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return []schema.Problem{syntaxProblem(err)}
+	}
+	if len(doc.Content) == 0 {
+		return []schema.Problem{{Message: "config file is empty"}}
+	}
+
+	var td config.TypeDef
+	if err := doc.Decode(&td); err != nil {
+		return []schema.Problem{{Message: fmt.Sprintf("failed to parse project configuration: %v", err)}}
+	}
+	if td.APIVersion == "" {
+		return []schema.Problem{{Line: 1, Column: 1, Message: "missing field \"apiVersion\""}}
+	}
+	kind := strings.ToLower(td.Kind)
+	p, ok := projects[kind]
+	if !ok {
+		return []schema.Problem{{Line: 1, Column: 1, Path: "kind",
+			Message: fmt.Sprintf("unknown kind %q, must be one of '%s'", td.Kind, strings.Join(kinds(), "|"))}}
+	}
+
+	pp := schema.Validate(&doc, schema.Generate(p))
+	pp = append(pp, suiteProblems(doc.Content[0], kind != config.KindEspresso)...)
+	schema.Sort(pp)
+	if len(pp) > 0 {
+		return pp
+	}
+
+	if err := validateProject(kind, cfgPath); err != nil {
+		pp = append(pp, schema.Problem{Message: err.Error()})
+	}
+	return pp
+}
+
+// PrintSchema prints the JSON Schema of the given kind of config.
+func PrintSchema(kind string) error {
+	p, ok := projects[strings.ToLower(kind)]
+	if !ok {
+		return fmt.Errorf("unknown kind %q, must be one of '%s'", kind, strings.Join(kinds(), "|"))
+	}
+
+	s := schema.Generate(p)
+	s.Title = fmt.Sprintf("saucectl %s config", strings.ToLower(kind))
+	s.Properties["apiVersion"].Enum = []string{config.VersionV1Alpha}
+	s.Properties["kind"].Enum = []string{strings.ToLower(kind)}
+	s.Properties["extends"] = &schema.Schema{Description: "The base config file(s) that this config extends."}
+	s.Properties["include"] = &schema.Schema{Description: "The config file(s) that are merged into this config."}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// suiteProblems checks that each suite has a unique name and, if required, that suites are defined. Suites aren't
+// required by kinds whose run command can also create a suite from flags.
+func suiteProblems(root *yaml.Node, required bool) []schema.Problem {
+	if root.Kind != yaml.MappingNode {
+		// Already reported by the schema.
+		return nil
+	}
+	suites := lookup(root, "suites")
+	if suites == nil || suites.Tag == "!!null" || (suites.Kind == yaml.SequenceNode && len(suites.Content) == 0) {
+		if !required {
+			return nil
+		}
+		return []schema.Problem{{Line: root.Line, Column: root.Column, Path: "suites", Message: "no suites defined"}}
+	}
+	if suites.Kind != yaml.SequenceNode {
+		// Already reported by the schema.
+		return nil
+	}
+
+	var pp []schema.Problem
+	names := map[string]bool{}
+	for i, s := range suites.Content {
+		path := fmt.Sprintf("suites[%d]", i)
+		if s.Kind != yaml.MappingNode {
+			continue
+		}
+		n := lookup(s, "name")
+		if n == nil || n.Value == "" {
+			pp = append(pp, schema.Problem{Line: s.Line, Column: s.Column, Path: path, Message: "missing suite name"})
+			continue
+		}
+		if names[n.Value] {
+			pp = append(pp, schema.Problem{Line: n.Line, Column: n.Column, Path: path + ".name",
+				Message: fmt.Sprintf("duplicate suite name %q", n.Value)})
+		}
+		names[n.Value] = true
+	}
+	return pp
+}
+
+// validateProject performs the same checks on the config as the run command does.
+func validateProject(kind string, cfgPath string) error {
+	switch kind {
+	case config.KindCypress:
+		p, err := cypress.FromFile(cfgPath)
+		if err != nil {
+			return err
+		}
+		return cypress.Validate(p)
+	case config.KindPlaywright:
+		_, err := playwright.FromFile(cfgPath)
+		return err
+	case config.KindPuppeteer:
+		_, err := puppeteer.FromFile(cfgPath)
+		return err
+	case config.KindTestcafe:
+		_, err := testcafe.FromFile(cfgPath)
+		return err
+	case config.KindEspresso:
+		p, err := espresso.FromFile(cfgPath)
+		if err != nil {
+			return err
+		}
+		// Without suites, the run command relies on flags for the suite and the apps, i.e. --name, --app and --testApp.
+		if len(p.Suites) == 0 {
+			return nil
+		}
+		return espresso.Validate(p)
+	case config.KindXcuitest:
+		p, err := xcuitest.FromFile(cfgPath)
+		if err != nil {
+			return err
+		}
+		xcuitest.SetDeviceDefaultValues(&p)
+		return xcuitest.Validate(p)
+	}
+	return errors.New("unknown framework configuration")
+}
+
+// syntaxProblem converts a yaml syntax error into a problem. The yaml package only reports the line as part of the
+// error message.
+func syntaxProblem(err error) schema.Problem {
+	var line int
+	msg := err.Error()
+	if _, scanErr := fmt.Sscanf(msg, "yaml: line %d:", &line); scanErr == nil {
+		msg = strings.TrimSpace(msg[strings.Index(msg, ":")+1:])
+		msg = strings.TrimSpace(msg[strings.Index(msg, ":")+1:])
+	}
+	return schema.Problem{Line: line, Column: 1, Message: msg}
+}
+
+// lookup returns the value of key in the mapping node n.
+func lookup(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func kinds() []string {
+	var kk []string
+	for k := range projects {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	return kk
+}
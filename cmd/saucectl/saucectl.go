@@ -2,10 +2,17 @@ package main
 
 import (
 	"fmt"
+	"github.com/saucelabs/saucectl/cli/command/artifacts"
+	"github.com/saucelabs/saucectl/cli/command/config"
 	"github.com/saucelabs/saucectl/cli/command/configure"
+	"github.com/saucelabs/saucectl/cli/command/ignore"
+	"github.com/saucelabs/saucectl/cli/command/jobs"
 	"github.com/saucelabs/saucectl/cli/command/run"
 	"github.com/saucelabs/saucectl/cli/command/signup"
+	"github.com/saucelabs/saucectl/cli/command/storage"
+	"github.com/saucelabs/saucectl/cli/command/validate"
 	"github.com/saucelabs/saucectl/cli/setup"
+	"github.com/saucelabs/saucectl/internal/requesth"
 	"os"
 	"time"
 
@@ -47,8 +54,19 @@ func main() {
 	cmd.Flags().BoolP("version", "v", false, "print version")
 
 	verbosity := cmd.PersistentFlags().Bool("verbose", false, "turn on verbose logging")
+	var network requesth.NetworkOptions
+	cmd.PersistentFlags().StringVar(&network.Proxy, "proxy", "", "The URL of the proxy to connect through. (default: $HTTPS_PROXY)")
+	cmd.PersistentFlags().StringVar(&network.ProxyAuth, "proxy-auth", "", "The credentials for the proxy, as username:password. (default: $SAUCE_PROXY_AUTH)")
+	cmd.PersistentFlags().StringVar(&network.CABundle, "ca-bundle", "", "The path to a PEM file of certificate authorities to trust in addition to the system's.")
+	cmd.PersistentFlags().BoolVar(&network.Insecure, "insecure", false, "Skips the verification of TLS certificates. Not recommended.")
 	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		setupLogging(*verbosity)
+		if network.ProxyAuth == "" {
+			network.ProxyAuth = os.Getenv("SAUCE_PROXY_AUTH")
+		}
+		if err := requesth.Configure(network); err != nil {
+			return err
+		}
 		setupSentry()
 		return nil
 	}
@@ -58,6 +76,12 @@ func main() {
 		run.Command(cli),
 		configure.Command(cli),
 		signup.Command(cli),
+		validate.Command(cli),
+		config.Command(cli),
+		jobs.Command(cli),
+		artifacts.Command(cli),
+		storage.Command(cli),
+		ignore.Command(cli),
 	)
 	if err := cmd.Execute(); err != nil {
 		os.Exit(1)
@@ -87,6 +111,8 @@ func setupSentry() {
 		Environment: "production",
 		Release:     fmt.Sprintf("saucectl@%s", version.Version),
 		Debug:       false,
+		// Reach sentry through the same proxy as Sauce Labs, including one that is only set by the config file.
+		HTTPTransport: requesth.ConfiguredTransport{},
 	})
 	if err != nil {
 		log.Debug().Err(err).Msg("Failed to setup sentry")
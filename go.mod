@@ -33,6 +33,7 @@ require (
 	github.com/stretchr/testify v1.4.0
 	golang.org/x/mod v0.4.2
 	gopkg.in/yaml.v2 v2.2.8
+	gopkg.in/yaml.v3 v3.0.1
 	gotest.tools v2.2.0+incompatible
 	gotest.tools/v3 v3.0.2
 )
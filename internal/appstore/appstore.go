@@ -1,7 +1,7 @@
 package appstore
 
 import (
-	"bytes"
+	"context"
 	"crypto/md5"
 	"encoding/json"
 	"errors"
@@ -15,7 +15,9 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/internal/bytesize"
 	"github.com/saucelabs/saucectl/internal/msg"
+	"github.com/saucelabs/saucectl/internal/progress"
 	"github.com/saucelabs/saucectl/internal/requesth"
 	"github.com/saucelabs/saucectl/internal/storage"
 )
@@ -43,8 +45,24 @@ type Links struct {
 
 // Item represents the metadata about the uploaded file.
 type Item struct {
-	ID   string `json:"id"`
-	ETag string `json:"etag"`
+	ID              string `json:"id"`
+	ETag            string `json:"etag"`
+	Name            string `json:"name"`
+	Kind            string `json:"kind"`
+	Size            int64  `json:"size"`
+	UploadTimestamp int64  `json:"upload_timestamp"`
+}
+
+// ListOptions represents the filters that are applied when listing files.
+type ListOptions struct {
+	// Query matches the names of files.
+	Query string
+	// Kind matches the kind of files, e.g. android or ios.
+	Kind string
+	// Since excludes files that were uploaded before, if set.
+	Since time.Time
+	// Until excludes files that were uploaded after, if set.
+	Until time.Time
 }
 
 // AppStore implements a remote file storage for storage.ProjectUploader.
@@ -54,47 +72,111 @@ type AppStore struct {
 	URL        string
 	Username   string
 	AccessKey  string
+	// UploadRetries is the number of times a failed upload is retried.
+	UploadRetries int
+	// RetryWait is the time to wait before retrying a failed upload. It doubles with every retry.
+	RetryWait time.Duration
+	// Cache remembers previous uploads, if set, so that Find does not have to list the entire storage.
+	Cache *Cache
 }
 
 // New returns an implementation for AppStore
 func New(url, username, accessKey string, timeout time.Duration) *AppStore {
 	return &AppStore{
-		HTTPClient: &http.Client{Timeout: timeout},
-		URL:        url,
-		Username:   username,
-		AccessKey:  accessKey,
+		HTTPClient:    requesth.NewClient(timeout),
+		URL:           url,
+		Username:      username,
+		AccessKey:     accessKey,
+		UploadRetries: 3,
+		RetryWait:     2 * time.Second,
 	}
 }
 
-// Upload uploads file to remote storage
-func (s *AppStore) Upload(name string) (storage.ArtifactMeta, error) {
-	body, contentType, err := readFile(name)
+// Upload uploads file to remote storage. The file is streamed from disk rather than read into memory. Failed uploads
+// are retried with an exponential backoff, as long as the failure is not caused by the request itself. The upload is
+// aborted when ctx is done.
+func (s *AppStore) Upload(ctx context.Context, name string) (storage.ArtifactMeta, error) {
+	fi, err := os.Stat(name)
 	if err != nil {
 		return storage.ArtifactMeta{}, err
 	}
 
-	request, err := createRequest(fmt.Sprintf("%s/v1/storage/upload", s.URL), s.Username, s.AccessKey, body, contentType)
+	progress.Show("Uploading %s", filepath.Base(name))
+	defer progress.Stop()
+
+	wait := s.RetryWait
+	for attempt := 0; ; attempt++ {
+		meta, err := s.upload(ctx, name, fi.Size())
+		if err == nil {
+			return meta, nil
+		}
+
+		var re retryableError
+		if !errors.As(err, &re) || attempt >= s.UploadRetries || ctx.Err() != nil {
+			var ue *url.Error
+			if errors.As(err, &ue) && ue.Timeout() && ctx.Err() == nil {
+				msg.LogUploadTimeoutSuggestion()
+			}
+			return storage.ArtifactMeta{}, fmt.Errorf("failed to upload project: %v", err)
+		}
+
+		log.Warn().Err(err).Msgf("Failed to upload %s, retrying in %s.", filepath.Base(name), wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return storage.ArtifactMeta{}, fmt.Errorf("failed to upload project: %v", ctx.Err())
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+// upload makes a single attempt to upload the file with the given name and size.
+func (s *AppStore) upload(ctx context.Context, name string, size int64) (storage.ArtifactMeta, error) {
+	file, err := os.Open(name)
 	if err != nil {
 		return storage.ArtifactMeta{}, err
 	}
+	defer file.Close()
 
-	resp, err := s.HTTPClient.Do(request)
-	if err != nil {
-		if err.(*url.Error).Timeout() {
-			msg.LogUploadTimeoutSuggestion()
-			return storage.ArtifactMeta{}, errors.New("failed to upload project")
+	pr, pw := io.Pipe()
+	// Unblocks the writer below if the request is aborted before the body has been fully read.
+	defer pr.Close()
+
+	hsh := md5.New()
+	writer := multipart.NewWriter(pw)
+	go func() {
+		part, err := writer.CreateFormFile("payload", filepath.Base(name))
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if _, err := io.Copy(part, &progressReader{r: io.TeeReader(file, hsh), name: filepath.Base(name), total: size}); err != nil {
+			pw.CloseWithError(err)
+			return
 		}
+		pw.CloseWithError(writer.Close())
+	}()
+
+	request, err := createRequest(ctx, fmt.Sprintf("%s/v1/storage/upload", s.URL), s.Username, s.AccessKey, pr, writer.FormDataContentType())
+	if err != nil {
 		return storage.ArtifactMeta{}, err
 	}
+
+	resp, err := s.HTTPClient.Do(request)
+	if err != nil {
+		return storage.ArtifactMeta{}, retryableError{err}
+	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 201 {
-		b, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return storage.ArtifactMeta{}, err
+		b, _ := io.ReadAll(resp.Body)
+		err := fmt.Errorf("unexpected response code:'%d', msg:'%v'", resp.StatusCode, string(b))
+		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+			return storage.ArtifactMeta{}, retryableError{err}
 		}
-		log.Error().Msgf("Failed to upload project. Invalid response %d, body: %v", resp.StatusCode, string(b))
-		return storage.ArtifactMeta{}, errors.New("failed to upload project")
+		return storage.ArtifactMeta{}, err
 	}
 
 	var ur UploadResponse
@@ -102,31 +184,37 @@ func (s *AppStore) Upload(name string) (storage.ArtifactMeta, error) {
 	if err := json.NewDecoder(resp.Body).Decode(&ur); err != nil {
 		return storage.ArtifactMeta{}, err
 	}
+	if s.Cache != nil {
+		s.Cache.Put(s.cacheKey(fmt.Sprintf("%x", hsh.Sum(nil))), ur.Item.ID)
+	}
 
 	return storage.ArtifactMeta{ID: ur.Item.ID}, err
 }
 
-func readFile(fileName string) (*bytes.Buffer, string, error) {
-	file, err := os.Open(fileName)
-	if err != nil {
-		return nil, "", err
-	}
-	defer file.Close()
+// retryableError marks an upload failure that may not occur again when retrying.
+type retryableError struct {
+	error
+}
 
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	defer writer.Close()
-	part, err := writer.CreateFormFile("payload", filepath.Base(file.Name()))
-	if err != nil {
-		return nil, "", err
-	}
-	io.Copy(part, file)
+// progressReader reports how much of a file has been read through it.
+type progressReader struct {
+	r     io.Reader
+	name  string
+	total int64
+	read  int64
+}
 
-	return body, writer.FormDataContentType(), nil
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if p.total > 0 {
+		progress.Update("Uploading %s %d%% (%s of %s)", p.name, p.read*100/p.total, bytesize.Format(p.read), bytesize.Format(p.total))
+	}
+	return n, err
 }
 
-func createRequest(url, username, accesskey string, body *bytes.Buffer, contentType string) (*http.Request, error) {
-	req, err := requesth.New(http.MethodPost, url, body)
+func createRequest(ctx context.Context, url, username, accesskey string, body io.Reader, contentType string) (*http.Request, error) {
+	req, err := requesth.NewWithContext(ctx, http.MethodPost, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +226,7 @@ func createRequest(url, username, accesskey string, body *bytes.Buffer, contentT
 }
 
 // Find looks for a file having the same signature.
-func (s *AppStore) Find(filename string) (storage.ArtifactMeta, error) {
+func (s *AppStore) Find(ctx context.Context, filename string) (storage.ArtifactMeta, error) {
 	if filename == "" {
 		return storage.ArtifactMeta{}, nil
 	}
@@ -148,9 +236,13 @@ func (s *AppStore) Find(filename string) (storage.ArtifactMeta, error) {
 		return storage.ArtifactMeta{}, err
 	}
 
+	if id, ok := s.findCached(ctx, hash); ok {
+		return storage.ArtifactMeta{ID: id}, nil
+	}
+
 	queryString := ""
 	for {
-		request, err := createLocateRequest(fmt.Sprintf("%s/v1/storage/list", s.URL), s.Username, s.AccessKey, queryString)
+		request, err := createLocateRequest(ctx, fmt.Sprintf("%s/v1/storage/list", s.URL), s.Username, s.AccessKey, queryString)
 		if err != nil {
 			return storage.ArtifactMeta{}, err
 		}
@@ -162,6 +254,9 @@ func (s *AppStore) Find(filename string) (storage.ArtifactMeta, error) {
 
 		for _, item := range lr.Items {
 			if item.ETag == hash {
+				if s.Cache != nil {
+					s.Cache.Put(s.cacheKey(hash), item.ID)
+				}
 				return storage.ArtifactMeta{ID: item.ID}, nil
 			}
 		}
@@ -173,6 +268,64 @@ func (s *AppStore) Find(filename string) (storage.ArtifactMeta, error) {
 	}
 }
 
+// findCached returns the storage ID of a previous upload of the file with the given hash, if the cache knows about it
+// and it still exists in storage.
+func (s *AppStore) findCached(ctx context.Context, hash string) (string, bool) {
+	if s.Cache == nil {
+		return "", false
+	}
+
+	key := s.cacheKey(hash)
+	id, ok := s.Cache.Get(key)
+	if !ok {
+		return "", false
+	}
+
+	item, err := s.Get(ctx, id)
+	if err != nil || item.ETag != hash {
+		log.Debug().Err(err).Str("id", id).Msg("Discarding stale upload cache entry.")
+		s.Cache.Remove(key)
+		return "", false
+	}
+	return id, true
+}
+
+// cacheKey returns the key of the file with the given hash in Cache. Since storage is separate for every user and
+// region, the key includes both.
+func (s *AppStore) cacheKey(hash string) string {
+	return fmt.Sprintf("%s@%s/%s", s.Username, s.URL, hash)
+}
+
+// Get returns the details of the file with the given id.
+func (s *AppStore) Get(ctx context.Context, id string) (Item, error) {
+	req, err := requesth.NewWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/storage/files/%s", s.URL, id), nil)
+	if err != nil {
+		return Item{}, err
+	}
+	req.SetBasicAuth(s.Username, s.AccessKey)
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return Item{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return Item{}, fmt.Errorf("file '%s' not found", id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return Item{}, fmt.Errorf("failed to get file '%s'; unexpected response code:'%d', msg:'%v'", id, resp.StatusCode, string(b))
+	}
+
+	// The file details are wrapped the same way as the response to an upload.
+	var ur UploadResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ur); err != nil {
+		return Item{}, err
+	}
+	return ur.Item, nil
+}
+
 func calculateBundleHash(filename string) (string, error) {
 	fs, err := os.Open(filename)
 	if err != nil {
@@ -187,8 +340,76 @@ func calculateBundleHash(filename string) (string, error) {
 	return hash, nil
 }
 
-func createLocateRequest(url, username, accesskey string, queryString string) (*http.Request, error) {
-	req, err := requesth.New(http.MethodGet, fmt.Sprintf("%s%s", url, queryString), nil)
+// List returns all files that match opts.
+func (s *AppStore) List(opts ListOptions) ([]Item, error) {
+	q := url.Values{}
+	if opts.Query != "" {
+		q.Set("q", opts.Query)
+	}
+	if opts.Kind != "" {
+		q.Set("kind", opts.Kind)
+	}
+	queryString := ""
+	if len(q) > 0 {
+		queryString = "?" + q.Encode()
+	}
+
+	var items []Item
+	for {
+		request, err := createLocateRequest(context.Background(), fmt.Sprintf("%s/v1/storage/list", s.URL), s.Username, s.AccessKey, queryString)
+		if err != nil {
+			return nil, err
+		}
+
+		lr, err := s.executeLocateRequest(request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range lr.Items {
+			uploaded := time.Unix(item.UploadTimestamp, 0)
+			if !opts.Since.IsZero() && uploaded.Before(opts.Since) {
+				continue
+			}
+			if !opts.Until.IsZero() && uploaded.After(opts.Until) {
+				continue
+			}
+			items = append(items, item)
+		}
+
+		queryString = lr.Links.Next
+		if queryString == "" {
+			return items, nil
+		}
+	}
+}
+
+// Delete deletes the file with the given id.
+func (s *AppStore) Delete(id string) error {
+	req, err := requesth.New(http.MethodDelete, fmt.Sprintf("%s/v1/storage/files/%s", s.URL, id), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(s.Username, s.AccessKey)
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("file '%s' not found", id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to delete file '%s'; unexpected response code:'%d', msg:'%v'", id, resp.StatusCode, string(b))
+	}
+	return nil
+}
+
+func createLocateRequest(ctx context.Context, url, username, accesskey string, queryString string) (*http.Request, error) {
+	req, err := requesth.NewWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s", url, queryString), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +425,11 @@ func (s *AppStore) executeLocateRequest(request *http.Request) (ListResponse, er
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return ListResponse{}, fmt.Errorf("storage list request failed; unexpected response code:'%d', msg:'%v'", resp.StatusCode, string(b))
+	}
+
 	var lr ListResponse
 	if err := json.NewDecoder(resp.Body).Decode(&lr); err != nil {
 		return ListResponse{}, err
@@ -1,17 +1,27 @@
 package appstore
 
 import (
+	"context"
 	"crypto/md5"
 	"fmt"
 	"gotest.tools/v3/fs"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"path"
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/saucelabs/saucectl/internal/requesth"
+	"github.com/stretchr/testify/assert"
 )
 
+func init() {
+	// Failed requests are retried, which tests should not have to wait for.
+	requesth.DefaultMinWait = 0
+}
+
 func TestAppStore_Upload(t *testing.T) {
 	dir := fs.NewDir(t, "bundles",
 		fs.WithFile("bundle-1.zip", "bundle-1-content", fs.WithMode(0644)),
@@ -53,7 +63,7 @@ func TestAppStore_Upload(t *testing.T) {
 
 	as := New(ts.URL, "fake-username", "fake-access-key", 15*time.Second)
 	for _, tt := range testCases {
-		artifact, err := as.Find(tt.look)
+		artifact, err := as.Find(context.Background(), tt.look)
 
 		if !reflect.DeepEqual(err, tt.wantErr) {
 			t.Errorf("Error: want: %v, got: %v", tt.wantErr, err)
@@ -63,3 +73,219 @@ func TestAppStore_Upload(t *testing.T) {
 		}
 	}
 }
+
+func TestAppStore_List(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		completeQuery := fmt.Sprintf("%s?%s", r.URL.Path, r.URL.RawQuery)
+		switch completeQuery {
+		case "/v1/storage/list?kind=android&q=app":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"items": [{"id":"old", "upload_timestamp": 1000}, {"id":"new", "upload_timestamp": 3000}], "links": {"next": "?kind=android&page=2&q=app"}}`))
+		case "/v1/storage/list?kind=android&page=2&q=app":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"items": [{"id":"newer", "upload_timestamp": 4000}]}`))
+		case "/v1/storage/list?":
+			w.WriteHeader(200)
+			w.Write([]byte(`{"items": [{"id":"any", "upload_timestamp": 1000}]}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	testCases := []struct {
+		name    string
+		opts    ListOptions
+		want    []string
+		wantErr bool
+	}{
+		{name: "no filters", opts: ListOptions{}, want: []string{"any"}},
+		{name: "all pages", opts: ListOptions{Query: "app", Kind: "android"}, want: []string{"old", "new", "newer"}},
+		{name: "since", opts: ListOptions{Query: "app", Kind: "android", Since: time.Unix(2000, 0)}, want: []string{"new", "newer"}},
+		{name: "until", opts: ListOptions{Query: "app", Kind: "android", Until: time.Unix(3000, 0)}, want: []string{"old", "new"}},
+		{name: "server error", opts: ListOptions{Kind: "ios"}, wantErr: true},
+	}
+
+	as := New(ts.URL, "fake-username", "fake-access-key", 15*time.Second)
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := as.List(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Error: want: %v, got: %v", tt.wantErr, err)
+			}
+			var got []string
+			for _, it := range items {
+				got = append(got, it.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IDs: want: %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAppStore_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/storage/files/existing":
+			w.WriteHeader(200)
+		case "/v1/storage/files/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	testCases := []struct {
+		id      string
+		wantErr error
+	}{
+		{id: "existing", wantErr: nil},
+		{id: "missing", wantErr: fmt.Errorf("file 'missing' not found")},
+		{id: "broken", wantErr: fmt.Errorf("failed to delete file 'broken'; unexpected response code:'500', msg:''")},
+	}
+
+	as := New(ts.URL, "fake-username", "fake-access-key", 15*time.Second)
+	for _, tt := range testCases {
+		err := as.Delete(tt.id)
+		if !reflect.DeepEqual(err, tt.wantErr) {
+			t.Errorf("Error: want: %v, got: %v", tt.wantErr, err)
+		}
+	}
+}
+
+func TestAppStore_UploadRetries(t *testing.T) {
+	dir := fs.NewDir(t, "bundles",
+		fs.WithFile("bundle.zip", "bundle-content", fs.WithMode(0644)))
+	defer dir.Remove()
+
+	testCases := []struct {
+		name         string
+		responses    []int
+		wantID       string
+		wantErr      bool
+		wantAttempts int
+	}{
+		{name: "first attempt succeeds", responses: []int{201}, wantID: "bundle-id", wantAttempts: 1},
+		{name: "retries server errors", responses: []int{500, 503, 201}, wantID: "bundle-id", wantAttempts: 3},
+		{name: "gives up eventually", responses: []int{500, 500, 500, 500, 500}, wantErr: true, wantAttempts: 4},
+		{name: "does not retry client errors", responses: []int{400, 201}, wantErr: true, wantAttempts: 1},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				f, h, err := r.FormFile("payload")
+				if err != nil {
+					t.Errorf("failed to read payload: %v", err)
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				b, _ := io.ReadAll(f)
+				if h.Filename != "bundle.zip" || string(b) != "bundle-content" {
+					t.Errorf("unexpected payload %s: %s", h.Filename, b)
+				}
+
+				code := tt.responses[attempts]
+				attempts++
+				w.WriteHeader(code)
+				if code == 201 {
+					w.Write([]byte(`{"item": {"id": "bundle-id"}}`))
+				}
+			}))
+			defer ts.Close()
+
+			as := New(ts.URL, "fake-username", "fake-access-key", 15*time.Second)
+			as.RetryWait = time.Millisecond
+
+			meta, err := as.Upload(context.Background(), path.Join(dir.Path(), "bundle.zip"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Error: want: %v, got: %v", tt.wantErr, err)
+			}
+			if meta.ID != tt.wantID {
+				t.Errorf("StorageID: want: %v, got: %v", tt.wantID, meta.ID)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("Attempts: want: %v, got: %v", tt.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestAppStore_UploadCancelled(t *testing.T) {
+	dir := fs.NewDir(t, "bundles",
+		fs.WithFile("bundle.zip", "bundle-content", fs.WithMode(0644)))
+	defer dir.Remove()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		// The run is interrupted while the upload fails.
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	as := New(ts.URL, "fake-username", "fake-access-key", 15*time.Second)
+	as.RetryWait = time.Hour
+
+	if _, err := as.Upload(ctx, path.Join(dir.Path(), "bundle.zip")); err == nil {
+		t.Errorf("Error: want: error, got: nil")
+	}
+	if attempts != 1 {
+		t.Errorf("Attempts: want: 1, got: %v", attempts)
+	}
+}
+
+func TestAppStore_FindCached(t *testing.T) {
+	dir := fs.NewDir(t, "bundles",
+		fs.WithFile("bundle.zip", "bundle-content", fs.WithMode(0644)))
+	defer dir.Remove()
+	b := md5.New()
+	b.Write([]byte("bundle-content"))
+	hash := fmt.Sprintf("%x", b.Sum(nil))
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		switch r.URL.Path {
+		case "/v1/storage/files/cached-id":
+			w.WriteHeader(200)
+			w.Write([]byte(fmt.Sprintf(`{"item": {"id":"cached-id", "etag": "%s"}}`, hash)))
+		case "/v1/storage/list":
+			w.WriteHeader(200)
+			w.Write([]byte(fmt.Sprintf(`{"items": [{"id":"listed-id", "etag": "%s"}]}`, hash)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	as := New(ts.URL, "fake-username", "fake-access-key", 15*time.Second)
+	as.Cache = NewCache(path.Join(dir.Path(), "uploads.json"))
+	filename := path.Join(dir.Path(), "bundle.zip")
+
+	// A cache hit is validated with a single request.
+	as.Cache.Put(as.cacheKey(hash), "cached-id")
+	artifact, err := as.Find(context.Background(), filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "cached-id", artifact.ID)
+	assert.Equal(t, []string{"/v1/storage/files/cached-id"}, requests)
+
+	// A stale entry falls back to listing the storage and is replaced.
+	requests = nil
+	as.Cache.Put(as.cacheKey(hash), "deleted-id")
+	artifact, err = as.Find(context.Background(), filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "listed-id", artifact.ID)
+	assert.Equal(t, []string{"/v1/storage/files/deleted-id", "/v1/storage/list"}, requests)
+	id, _ := as.Cache.Get(as.cacheKey(hash))
+	assert.Equal(t, "listed-id", id)
+}
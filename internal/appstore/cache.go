@@ -0,0 +1,108 @@
+package appstore
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// cacheTTL is the duration for which an upload is remembered. Sauce Labs retains uploaded files for 60 days.
+var cacheTTL = 30 * 24 * time.Hour
+
+// Cache remembers the files that were uploaded previously, so that they can be found without listing the entire
+// storage.
+type Cache struct {
+	Path string `json:"-"`
+
+	// Entries maps a key, which consists of the storage location and the MD5 hash of a file, to the file's upload.
+	Entries map[string]CacheEntry `json:"entries"`
+
+	lock sync.Mutex
+}
+
+// CacheEntry represents a previously uploaded file.
+type CacheEntry struct {
+	ID      string    `json:"id"`
+	Expires time.Time `json:"expires"`
+}
+
+// DefaultCachePath returns the default location of the upload cache.
+func DefaultCachePath() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".sauce", "uploads.json")
+}
+
+// NewCache loads the cache from path. A missing or corrupt file results in an empty cache. Expired entries are dropped.
+func NewCache(path string) *Cache {
+	c := &Cache{Path: path}
+
+	if b, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(b, c); err != nil {
+			log.Warn().Err(err).Str("path", path).Msg("Ignoring corrupt upload cache.")
+		}
+	}
+	if c.Entries == nil {
+		c.Entries = map[string]CacheEntry{}
+	}
+
+	now := time.Now()
+	for k, e := range c.Entries {
+		if now.After(e.Expires) {
+			delete(c.Entries, k)
+		}
+	}
+
+	return c
+}
+
+// Get returns the storage ID of the file with the given key.
+func (c *Cache) Get(key string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, ok := c.Entries[key]
+	if !ok || time.Now().After(e.Expires) {
+		return "", false
+	}
+	return e.ID, true
+}
+
+// Put remembers the storage ID of the file with the given key and persists the cache.
+func (c *Cache) Put(key, id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.Entries[key] = CacheEntry{ID: id, Expires: time.Now().Add(cacheTTL)}
+	c.save()
+}
+
+// Remove forgets the file with the given key and persists the cache.
+func (c *Cache) Remove(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.Entries[key]; !ok {
+		return
+	}
+	delete(c.Entries, key)
+	c.save()
+}
+
+func (c *Cache) save() {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to encode upload cache.")
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0700); err != nil {
+		log.Warn().Err(err).Msg("Failed to create upload cache folder.")
+		return
+	}
+	if err := os.WriteFile(c.Path, b, 0600); err != nil {
+		log.Warn().Err(err).Str("path", c.Path).Msg("Failed to write upload cache.")
+	}
+}
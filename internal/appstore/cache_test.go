@@ -0,0 +1,56 @@
+package appstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_PutGetRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "uploads.json")
+
+	c := NewCache(path)
+	_, ok := c.Get("key")
+	assert.False(t, ok)
+
+	c.Put("key", "storage-id")
+	c.Put("other", "other-id")
+
+	got := NewCache(path)
+	id, ok := got.Get("key")
+	assert.True(t, ok)
+	assert.Equal(t, "storage-id", id)
+
+	got.Remove("key")
+	_, ok = NewCache(path).Get("key")
+	assert.False(t, ok)
+	_, ok = NewCache(path).Get("other")
+	assert.True(t, ok)
+}
+
+func TestNewCache(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.Empty(t, NewCache(filepath.Join(dir, "missing.json")).Entries)
+
+	corrupt := filepath.Join(dir, "corrupt.json")
+	assert.NoError(t, os.WriteFile(corrupt, []byte("{not json"), 0600))
+	assert.Empty(t, NewCache(corrupt).Entries)
+
+	expired := filepath.Join(dir, "expired.json")
+	c := NewCache(expired)
+	c.Entries["old"] = CacheEntry{ID: "old-id", Expires: time.Now().Add(-time.Hour)}
+	c.Put("new", "new-id")
+	assert.Equal(t, []string{"new"}, keys(NewCache(expired).Entries))
+}
+
+func keys(m map[string]CacheEntry) []string {
+	var kk []string
+	for k := range m {
+		kk = append(kk, k)
+	}
+	return kk
+}
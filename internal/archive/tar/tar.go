@@ -2,7 +2,6 @@ package tar
 
 import (
 	"archive/tar"
-	"bytes"
 	"github.com/rs/zerolog/log"
 	"io"
 	"os"
@@ -15,6 +14,8 @@ import (
 // Options represents the options applied when archiving files.
 type Options struct {
 	Permission *Permission
+	// PreserveSymlinks adds symbolic links as links, rather than adding the files they point to.
+	PreserveSymlinks bool
 }
 
 // Permission represents the permissions applied when archiving files.
@@ -24,51 +25,105 @@ type Permission struct {
 	GID  int   // Group ID of owner
 }
 
-// addFileToArchive adds a file into an archive.
-func addFileToArchive(fileName string, fileInfo os.FileInfo, rootFolder string, matcher sauceignore.Matcher, opts Options, w *tar.Writer) error {
-	if matcher.Match(strings.Split(fileName, string(os.PathSeparator)), fileInfo.IsDir()) {
+// archiver adds files to a tar archive.
+type archiver struct {
+	w          *tar.Writer
+	rootFolder string
+	matcher    sauceignore.Matcher
+	opts       Options
+
+	// ancestors contains the real paths of the directories that are being added, in order to detect symlink loops.
+	ancestors map[string]bool
+}
+
+// add adds the file at fileName into the archive. Directories are added recursively. Unless symlinks are preserved,
+// symlinks that are broken or lead back into a directory that is being added are skipped.
+func (a *archiver) add(fileName string) error {
+	fileInfo, err := os.Lstat(fileName)
+	if err != nil {
+		return err
+	}
+
+	if fileInfo.Mode().Type() == os.ModeSymlink && !a.opts.PreserveSymlinks {
+		if fileInfo, err = os.Stat(fileName); err != nil {
+			log.Warn().Err(err).Str("fileName", fileName).Msg("Skipping broken symlink")
+			return nil
+		}
+	}
+
+	if a.matcher.Match(strings.Split(fileName, string(os.PathSeparator)), fileInfo.IsDir()) {
 		log.Debug().Str("fileName", fileName).Msg("Ignoring file")
 		return nil
 	}
-	header, err := tar.FileInfoHeader(fileInfo, fileName)
+
+	if !fileInfo.IsDir() {
+		return a.addFile(fileName, fileInfo)
+	}
+
+	realPath, err := filepath.EvalSymlinks(fileName)
 	if err != nil {
 		return err
 	}
+	if a.ancestors[realPath] {
+		log.Warn().Str("fileName", fileName).Msg("Skipping symlink loop")
+		return nil
+	}
+	a.ancestors[realPath] = true
+	defer delete(a.ancestors, realPath)
 
-	if opts.Permission != nil {
-		header.Mode = opts.Permission.Mode
-		header.Uid = opts.Permission.UID
-		header.Gid = opts.Permission.GID
+	if err := a.addFile(fileName, fileInfo); err != nil {
+		return err
 	}
 
-	relName := filepath.Base(fileName)
-	if rootFolder != "" {
-		relName, err = filepath.Rel(rootFolder, fileName)
-		if err != nil {
+	files, err := os.ReadDir(fileName)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := a.add(filepath.Join(fileName, f.Name())); err != nil {
 			return err
 		}
 	}
+	return nil
+}
 
-	relName = filepath.ToSlash(relName)
-	header.Name = relName
-
+// addFile adds a single file, directory or symlink into the archive. The content of files is streamed from disk.
+func (a *archiver) addFile(fileName string, fileInfo os.FileInfo) error {
+	var linkTarget string
 	if fileInfo.Mode().Type() == os.ModeSymlink {
-		linkTarget, err := filepath.EvalSymlinks(fileName)
-		if err != nil {
+		var err error
+		if linkTarget, err = os.Readlink(fileName); err != nil {
 			return err
 		}
-		relLinkName, err := filepath.Rel(filepath.Dir(fileName), linkTarget)
+	}
+
+	header, err := tar.FileInfoHeader(fileInfo, filepath.ToSlash(linkTarget))
+	if err != nil {
+		return err
+	}
+
+	if a.opts.Permission != nil {
+		header.Mode = a.opts.Permission.Mode
+		header.Uid = a.opts.Permission.UID
+		header.Gid = a.opts.Permission.GID
+	}
+
+	relName := filepath.Base(fileName)
+	if a.rootFolder != "" {
+		relName, err = filepath.Rel(a.rootFolder, fileName)
 		if err != nil {
 			return err
 		}
-		header.Linkname = relLinkName
 	}
 
-	if err := w.WriteHeader(header); err != nil {
+	relName = filepath.ToSlash(relName)
+	header.Name = relName
+
+	if err := a.w.WriteHeader(header); err != nil {
 		return err
 	}
 
-	if fileInfo.IsDir() || fileInfo.Mode().Type() == os.ModeSymlink {
+	if !fileInfo.Mode().IsRegular() {
 		return nil
 	}
 
@@ -78,43 +133,34 @@ func addFileToArchive(fileName string, fileInfo os.FileInfo, rootFolder string,
 	}
 	defer srcFile.Close()
 
-	_, err = io.Copy(w, srcFile)
-	if err != nil {
-		return err
-	}
-	return nil
+	_, err = io.Copy(a.w, srcFile)
+	return err
 }
 
-// Archive archives the resource and exclude files and folders based on sauceignore logic.
-func Archive(src string, matcher sauceignore.Matcher, opts Options) (io.Reader, error) {
-	bb := new(bytes.Buffer)
-	w := tar.NewWriter(bb)
-	defer w.Close()
-
+// Archive archives the resource and exclude files and folders based on sauceignore logic. The archive is streamed, i.e.
+// it is created while it is being read. Errors that occur while archiving are returned when reading. The reader must be
+// closed, so that archiving stops if the archive isn't read to the end.
+func Archive(src string, matcher sauceignore.Matcher, opts Options) (io.ReadCloser, error) {
 	infoSrc, err := os.Stat(src)
 	if err != nil {
 		return nil, err
 	}
 
-	// Single file addition
-	if !infoSrc.IsDir() {
-		err = addFileToArchive(src, infoSrc, "", matcher, opts, w)
-		if err != nil {
-			return nil, err
-		}
-		return bytes.NewReader(bb.Bytes()), nil
+	a := archiver{matcher: matcher, opts: opts, ancestors: map[string]bool{}}
+	// Single files are added by their name, rather than relative to a root folder.
+	if infoSrc.IsDir() {
+		a.rootFolder = src
 	}
 
-	walker := func(file string, fileInfo os.FileInfo, err error) error {
-		err = addFileToArchive(file, fileInfo, src, matcher, opts, w)
-		if err != nil {
-			return err
+	pr, pw := io.Pipe()
+	go func() {
+		a.w = tar.NewWriter(pw)
+		err := a.add(src)
+		if err == nil {
+			err = a.w.Close()
 		}
-		return nil
-	}
+		pw.CloseWithError(err)
+	}()
 
-	if err := filepath.Walk(src, walker); err != nil {
-		return nil, err
-	}
-	return bytes.NewReader(bb.Bytes()), nil
+	return pr, nil
 }
@@ -5,7 +5,9 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
+	"time"
 
 	"gotest.tools/assert"
 	"gotest.tools/v3/fs"
@@ -105,3 +107,92 @@ func TestArchive(t *testing.T) {
 		})
 	}
 }
+
+func TestArchive_Symlinks(t *testing.T) {
+	dir := fs.NewDir(t, "tests",
+		fs.WithDir("pkg", fs.WithFile("index.js", "index", fs.WithMode(0644))),
+		fs.WithDir("node_modules"))
+	defer dir.Remove()
+	for link, target := range map[string]string{"pkg/loop": "..", "node_modules/pkg": "../pkg", "dangling": "missing"} {
+		if err := os.Symlink(target, dir.Join(link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		options Options
+		want    map[string]string
+	}{
+		{
+			name:    "follow symlinks",
+			options: Options{},
+			want: map[string]string{
+				"node_modules/pkg/index.js": "index",
+				"pkg/index.js":              "index",
+			},
+		},
+		{
+			name:    "preserve symlinks",
+			options: Options{PreserveSymlinks: true},
+			want: map[string]string{
+				"dangling ->":         "missing",
+				"node_modules/pkg ->": "../pkg",
+				"pkg/index.js":        "index",
+				"pkg/loop ->":         "..",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := Archive(dir.Path(), sauceignore.NewMatcher([]sauceignore.Pattern{}), tt.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := map[string]string{}
+			tr := archTar.NewReader(reader)
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				switch header.Typeflag {
+				case archTar.TypeReg:
+					b, _ := io.ReadAll(tr)
+					got[header.Name] = string(b)
+				case archTar.TypeSymlink:
+					got[header.Name+" ->"] = header.Linkname
+				}
+			}
+			assert.DeepEqual(t, tt.want, got)
+		})
+	}
+}
+
+func TestArchive_Close(t *testing.T) {
+	dir := fs.NewDir(t, "tests", fs.WithFile("big.bin", string(make([]byte, 1<<20))))
+	defer dir.Remove()
+
+	before := runtime.NumGoroutine()
+	reader, err := Archive(dir.Path(), sauceignore.NewMatcher([]sauceignore.Pattern{}), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Closing the reader without reading the archive stops archiving, rather than leaving it blocked on writing.
+	if err := reader.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatal("archiving did not stop after the reader was closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -2,63 +2,159 @@ package zip
 
 import (
 	"archive/zip"
+	"errors"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/rs/zerolog/log"
 	"github.com/saucelabs/saucectl/internal/sauceignore"
 )
 
+// modTime is the modification time of every file in an archive. Using a fixed time, rather than the time a file was
+// last modified, makes archives of identical files identical.
+var modTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ErrTooLarge is returned once an archive grows beyond Options.MaxSize.
+var ErrTooLarge = errors.New("archive exceeds the maximum size")
+
+// Options represents the options applied when archiving files.
+type Options struct {
+	// PreserveSymlinks adds symbolic links as links, rather than adding the files they point to.
+	PreserveSymlinks bool
+	// MaxSize is the size in bytes that the archive may not exceed. Adding files fails with ErrTooLarge as soon as it's
+	// exceeded. No limit if 0.
+	MaxSize int64
+}
+
+// Entry represents a file that has been added to an archive.
+type Entry struct {
+	Name string
+	// Size is the uncompressed size of the file.
+	Size int64
+}
+
 // Writer is a wrapper around zip.Writer and implements zip archiving for archive.Writer.
 type Writer struct {
-	W *zip.Writer
-	M sauceignore.Matcher
+	W       *zip.Writer
+	M       sauceignore.Matcher
+	Options Options
+	// Entries lists the files that have been added to the archive.
+	Entries []Entry
+
+	dst *limitWriter
+}
+
+// limitWriter counts the bytes written to w and fails once there are more than max, unless max is 0.
+type limitWriter struct {
+	w   io.Writer
+	n   int64
+	max int64
+}
+
+func (l *limitWriter) Write(p []byte) (int, error) {
+	if l.max > 0 && l.n+int64(len(p)) > l.max {
+		return 0, ErrTooLarge
+	}
+	n, err := l.w.Write(p)
+	l.n += int64(n)
+	return n, err
 }
 
 // NewFileWriter returns a new Writer that archives files to name.
-func NewFileWriter(name string, matcher sauceignore.Matcher) (Writer, error) {
+func NewFileWriter(name string, matcher sauceignore.Matcher, opts Options) (Writer, error) {
 	f, err := os.Create(name)
 	if err != nil {
 		return Writer{}, err
 	}
 
-	w := Writer{W: zip.NewWriter(f), M: matcher}
-
-	return w, nil
+	return New(f, matcher, opts)
 }
 
 // New returns a new Writer that archives files to the specified io.Writer.
-func New(f io.Writer, matcher sauceignore.Matcher) (Writer, error) {
-	w := Writer{W: zip.NewWriter(f), M: matcher}
+func New(f io.Writer, matcher sauceignore.Matcher, opts Options) (Writer, error) {
+	dst := &limitWriter{w: f, max: opts.MaxSize}
+	w := Writer{W: zip.NewWriter(dst), M: matcher, Options: opts, dst: dst}
 	return w, nil
 }
 
-// Add adds the file at src to the destination dst in the archive.
+// Size returns the number of bytes that have been written to the destination of the archive so far. Since content is
+// compressed in chunks, it may lag behind the files that have been added, until the archive is closed.
+func (w *Writer) Size() int64 {
+	return w.dst.n
+}
+
+// Add adds the file at src to the destination dst in the archive. Directories are added recursively, in lexical order.
+// The archive is reproducible: timestamps are fixed and permissions are normalized to 0644, or 0755 for executables.
+// Unless symlinks are preserved, symlinks that are broken or lead back into a directory that is being added are
+// skipped.
 func (w *Writer) Add(src, dst string) error {
-	finfo, err := os.Stat(src)
+	return w.add(src, dst, map[string]bool{})
+}
+
+// AddContents adds the files in the directory src to the destination dst in the archive, the same way as Add, but
+// without src itself. Symlinks that lead back to src are skipped like any other symlink loop.
+func (w *Writer) AddContents(src, dst string) error {
+	realPath, err := filepath.EvalSymlinks(src)
+	if err != nil {
+		return err
+	}
+	ancestors := map[string]bool{realPath: true}
+
+	files, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		log.Debug().Str("name", f.Name()).Msg("Adding to archive")
+		if err := w.add(filepath.Join(src, f.Name()), dst, ancestors); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// add adds the file at src to the destination dst in the archive. ancestors contains the real paths of the directories
+// that are being added, in order to detect symlink loops.
+func (w *Writer) add(src, dst string, ancestors map[string]bool) error {
+	finfo, err := os.Lstat(src)
 	if err != nil {
 		return err
 	}
 
+	if finfo.Mode()&os.ModeSymlink != 0 && !w.Options.PreserveSymlinks {
+		if finfo, err = os.Stat(src); err != nil {
+			log.Warn().Err(err).Str("path", src).Msg("Skipping broken symlink.")
+			return nil
+		}
+	}
+
 	// Only will be applied if we have .sauceignore file and have patterns to exclude files and folders
 	if w.M.Match(strings.Split(src, string(os.PathSeparator)), finfo.IsDir()) {
 		return nil
 	}
 
+	if finfo.Mode()&os.ModeSymlink != 0 {
+		return w.addSymlink(src, path.Join(dst, finfo.Name()))
+	}
 	if !finfo.IsDir() {
-		w, err := w.W.Create(path.Join(dst, finfo.Name()))
-		if err != nil {
-			return err
-		}
-		b, err := os.ReadFile(src)
-		if err != nil {
-			return err
-		}
-		_, err = w.Write(b)
+		return w.addFile(src, path.Join(dst, finfo.Name()), finfo.Mode())
+	}
+
+	realPath, err := filepath.EvalSymlinks(src)
+	if err != nil {
 		return err
 	}
+	if ancestors[realPath] {
+		log.Warn().Str("path", src).Msg("Skipping symlink loop.")
+		return nil
+	}
+	ancestors[realPath] = true
+	defer delete(ancestors, realPath)
 
 	files, err := os.ReadDir(src)
 	if err != nil {
@@ -69,7 +165,7 @@ func (w *Writer) Add(src, dst string) error {
 		base := filepath.Base(src)
 		rebase := path.Join(dst, base)
 		fpath := filepath.Join(src, f.Name())
-		if err := w.Add(fpath, rebase); err != nil {
+		if err := w.add(fpath, rebase, ancestors); err != nil {
 			return err
 		}
 	}
@@ -77,6 +173,69 @@ func (w *Writer) Add(src, dst string) error {
 	return nil
 }
 
+// addFile streams the content of the file at src to name in the archive.
+func (w *Writer) addFile(src, name string, mode os.FileMode) error {
+	h := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modTime,
+	}
+	h.SetMode(normalizeMode(mode))
+	fw, err := w.W.CreateHeader(h)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(fw, f)
+	if err != nil {
+		return err
+	}
+	w.Entries = append(w.Entries, Entry{Name: name, Size: n})
+	return nil
+}
+
+// addSymlink adds the symlink at src as name to the archive. As is convention, the content of the entry is the target
+// of the link.
+func (w *Writer) addSymlink(src, name string) error {
+	target, err := os.Readlink(src)
+	if err != nil {
+		return err
+	}
+
+	h := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store,
+		Modified: modTime,
+	}
+	h.SetMode(os.ModeSymlink | 0777)
+	fw, err := w.W.CreateHeader(h)
+	if err != nil {
+		return err
+	}
+
+	n, err := fw.Write([]byte(filepath.ToSlash(target)))
+	if err != nil {
+		return err
+	}
+	w.Entries = append(w.Entries, Entry{Name: name, Size: int64(n)})
+	return nil
+}
+
+// normalizeMode returns the permissions that a file with mode m has within an archive. Only the executable bit is
+// retained, since the other bits depend on the umask of the machine the archive is created on.
+func normalizeMode(m os.FileMode) os.FileMode {
+	if m&0111 != 0 {
+		return 0755
+	}
+	return 0644
+}
+
 // Close closes the archive. Adding more files to the archive is not possible after this.
 func (w *Writer) Close() error {
 	return w.W.Close()
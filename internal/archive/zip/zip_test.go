@@ -2,9 +2,16 @@ package zip
 
 import (
 	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
 	"os"
+	"path"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/fs"
 
@@ -92,3 +99,166 @@ func TestZipper_Add(t *testing.T) {
 		})
 	}
 }
+
+func TestWriter_Add_Reproducible(t *testing.T) {
+	dir := fs.NewDir(t, "tests",
+		fs.WithDir("e2e", fs.WithFile("a.spec.js", "a", fs.WithMode(0644))),
+		fs.WithFile("run.sh", "#!/bin/sh", fs.WithMode(0755)))
+	defer dir.Remove()
+
+	archive := func() []byte {
+		var b bytes.Buffer
+		z, _ := New(&b, sauceignore.NewMatcher([]sauceignore.Pattern{}), Options{})
+		if err := z.Add(dir.Path(), ""); err != nil {
+			t.Fatalf("failed to archive: %v", err)
+		}
+		if err := z.Close(); err != nil {
+			t.Fatalf("failed to close archive: %v", err)
+		}
+		return b.Bytes()
+	}
+
+	first := archive()
+
+	// Neither timestamps nor permissions other than the executable bit affect the archive.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(dir.Join("e2e", "a.spec.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(dir.Join("e2e", "a.spec.js"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(first, archive()) {
+		t.Errorf("archives of identical files differ")
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(first), int64(len(first)))
+	if err != nil {
+		t.Fatalf("failed to read archive: %v", err)
+	}
+	modes := map[string]os.FileMode{}
+	for _, f := range r.File {
+		modes[path.Base(f.Name)] = f.Mode()
+	}
+	want := map[string]os.FileMode{"a.spec.js": 0644, "run.sh": 0755}
+	if !reflect.DeepEqual(modes, want) {
+		t.Errorf("got modes %v, want %v", modes, want)
+	}
+}
+
+func TestWriter_Add_Symlinks(t *testing.T) {
+	dir := fs.NewDir(t, "tests",
+		fs.WithDir("pkg", fs.WithFile("index.js", "index", fs.WithMode(0644))),
+		fs.WithDir("node_modules"))
+	defer dir.Remove()
+	for link, target := range map[string]string{"pkg/loop": "..", "node_modules/pkg": "../pkg", "dangling": "missing"} {
+		if err := os.Symlink(target, dir.Join(link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive := func(opts Options) map[string]string {
+		var b bytes.Buffer
+		z, _ := New(&b, sauceignore.NewMatcher([]sauceignore.Pattern{}), opts)
+		if err := z.Add(dir.Path(), ""); err != nil {
+			t.Fatalf("failed to archive: %v", err)
+		}
+		if err := z.Close(); err != nil {
+			t.Fatalf("failed to close archive: %v", err)
+		}
+
+		r, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		if err != nil {
+			t.Fatalf("failed to read archive: %v", err)
+		}
+		files := map[string]string{}
+		for _, f := range r.File {
+			rc, _ := f.Open()
+			content, _ := io.ReadAll(rc)
+			rc.Close()
+			name := strings.TrimPrefix(f.Name, path.Base(dir.Path())+"/")
+			if f.Mode()&os.ModeSymlink != 0 {
+				name += " ->"
+			}
+			files[name] = string(content)
+		}
+		return files
+	}
+
+	followed := archive(Options{})
+	want := map[string]string{
+		"node_modules/pkg/index.js": "index",
+		"pkg/index.js":              "index",
+	}
+	if !reflect.DeepEqual(followed, want) {
+		t.Errorf("following symlinks: got %v, want %v", followed, want)
+	}
+
+	preserved := archive(Options{PreserveSymlinks: true})
+	want = map[string]string{
+		"dangling ->":         "missing",
+		"node_modules/pkg ->": "../pkg",
+		"pkg/index.js":        "index",
+		"pkg/loop ->":         "..",
+	}
+	if !reflect.DeepEqual(preserved, want) {
+		t.Errorf("preserving symlinks: got %v, want %v", preserved, want)
+	}
+}
+
+func TestWriter_AddContents_Symlinks(t *testing.T) {
+	dir := fs.NewDir(t, "tests",
+		fs.WithFile("index.js", "index", fs.WithMode(0644)),
+		fs.WithDir("node_modules"))
+	defer dir.Remove()
+	// Workspace links often point back to the project root.
+	if err := os.Symlink("..", dir.Join("node_modules", "project")); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	z, _ := New(&b, sauceignore.NewMatcher([]sauceignore.Pattern{}), Options{})
+	if err := z.AddContents(dir.Path(), ""); err != nil {
+		t.Fatalf("failed to archive: %v", err)
+	}
+	if err := z.Close(); err != nil {
+		t.Fatalf("failed to close archive: %v", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("failed to read archive: %v", err)
+	}
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	if want := []string{"index.js"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+}
+
+func TestWriter_Add_MaxSize(t *testing.T) {
+	// Random content can't be compressed, so the archive grows by the size of every file.
+	content := make([]byte, 64*1024)
+	rand.New(rand.NewSource(1)).Read(content)
+	dir := fs.NewDir(t, "tests",
+		fs.WithFile("a.bin", string(content)),
+		fs.WithFile("b.bin", string(content)),
+		fs.WithFile("c.bin", string(content)))
+	defer dir.Remove()
+
+	var b bytes.Buffer
+	z, _ := New(&b, sauceignore.NewMatcher([]sauceignore.Pattern{}), Options{MaxSize: 100 * 1024})
+	err := z.AddContents(dir.Path(), "")
+	if !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("got error %v, want %v", err, ErrTooLarge)
+	}
+	if len(z.Entries) > 1 {
+		t.Errorf("got %d entries, want archiving to stop at the file that exceeds the limit", len(z.Entries))
+	}
+	if b.Len() > 100*1024 {
+		t.Errorf("wrote %d bytes, want at most %d", b.Len(), 100*1024)
+	}
+}
@@ -0,0 +1,47 @@
+package bytesize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{in: "100", want: 100},
+		{in: "100B", want: 100},
+		{in: "500MB", want: 500000000},
+		{in: "1.5 GB", want: 1500000000},
+		{in: "2kb", want: 2000},
+		{in: "100MiB", want: 100 << 20},
+		{in: "", wantErr: true},
+		{in: "MB", wantErr: true},
+		{in: "100 parsecs", wantErr: true},
+	}
+	for _, tt := range testCases {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	testCases := map[int64]string{
+		0:          "0 B",
+		999:        "999 B",
+		1500:       "1.5 kB",
+		1000000:    "1.0 MB",
+		1234567890: "1.2 GB",
+	}
+	for b, want := range testCases {
+		assert.Equal(t, want, Format(b), b)
+	}
+}
@@ -3,9 +3,12 @@ package config
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -18,12 +21,18 @@ type Metadata struct {
 
 // SauceConfig represents sauce labs related settings.
 type SauceConfig struct {
-	Region      string            `yaml:"region,omitempty" json:"region"`
-	Metadata    Metadata          `yaml:"metadata,omitempty" json:"metadata"`
-	Tunnel      Tunnel            `yaml:"tunnel,omitempty" json:"tunnel,omitempty"`
-	Concurrency int               `yaml:"concurrency,omitempty" json:"concurrency,omitempty"`
-	Sauceignore string            `yaml:"sauceignore,omitempty" json:"sauceignore,omitempty"`
-	Experiments map[string]string `yaml:"experiments,omitempty" json:"experiments,omitempty"`
+	Region           string            `yaml:"region,omitempty" json:"region"`
+	Metadata         Metadata          `yaml:"metadata,omitempty" json:"metadata"`
+	Tunnel           Tunnel            `yaml:"tunnel,omitempty" json:"tunnel,omitempty"`
+	Concurrency      int               `yaml:"concurrency,omitempty" json:"concurrency,omitempty"`
+	Retries          int               `yaml:"retries,omitempty" json:"retries,omitempty"`
+	SuiteTimeout     time.Duration     `yaml:"suiteTimeout,omitempty" json:"suiteTimeout,omitempty"`
+	Sauceignore      string            `yaml:"sauceignore,omitempty" json:"sauceignore,omitempty"`
+	PreserveSymlinks bool              `yaml:"preserveSymlinks,omitempty" json:"preserveSymlinks,omitempty"`
+	UseGitignore     bool              `yaml:"useGitignore,omitempty" json:"useGitignore,omitempty"`
+	Network          Network           `yaml:"network,omitempty" json:"network,omitempty"`
+	MaxBundleSize    string            `yaml:"maxBundleSize,omitempty" json:"maxBundleSize,omitempty"`
+	Experiments      map[string]string `yaml:"experiments,omitempty" json:"experiments,omitempty"`
 }
 
 // DeviceOptions represents the devices capabilities required from a real device.
@@ -64,7 +73,7 @@ const (
 // ArtifactDownload represents the test artifacts configuration.
 type ArtifactDownload struct {
 	Match     []string `yaml:"match,omitempty" json:"match"`
-	When      When     `yaml:"when,omitempty" json:"when"`
+	When      When     `yaml:"when,omitempty" json:"when" enum:"fail,pass,never,always"`
 	Directory string   `yaml:"directory,omitempty" json:"directory"`
 }
 
@@ -73,12 +82,37 @@ type Artifacts struct {
 	Download ArtifactDownload `yaml:"download,omitempty" json:"download"`
 }
 
+// Reporters represents the reporter configuration. The table reporter is always active, all others are opt-in.
+type Reporters struct {
+	JUnit JUnitReporter `yaml:"junit,omitempty" json:"-"`
+	JSON  JSONReporter  `yaml:"json,omitempty" json:"-"`
+}
+
+// JUnitReporter represents the configuration for the junit reporter, which merges the junit reports of all jobs.
+type JUnitReporter struct {
+	Enabled  bool   `yaml:"enabled,omitempty" json:"-"`
+	Filename string `yaml:"filename,omitempty" json:"-"`
+}
+
+// JSONReporter represents the configuration for the json reporter, which writes a machine-readable summary of the run.
+type JSONReporter struct {
+	Enabled  bool   `yaml:"enabled,omitempty" json:"-"`
+	Filename string `yaml:"filename,omitempty" json:"-"`
+}
+
 // Tunnel represents a sauce labs tunnel.
 type Tunnel struct {
 	ID     string `yaml:"id,omitempty" json:"id"`
 	Parent string `yaml:"parent,omitempty" json:"parent,omitempty"`
 }
 
+// Network represents the settings of how saucectl connects to Sauce Labs.
+type Network struct {
+	Proxy    string `yaml:"proxy,omitempty" json:"proxy,omitempty"`
+	CABundle string `yaml:"caBundle,omitempty" json:"caBundle,omitempty"`
+	Insecure bool   `yaml:"insecure,omitempty" json:"insecure,omitempty"`
+}
+
 // TypeDef represents the type definition of the config.
 type TypeDef struct {
 	APIVersion string `yaml:"apiVersion,omitempty"`
@@ -96,7 +130,7 @@ const (
 
 // Docker represents docker settings.
 type Docker struct {
-	FileTransfer DockerFileMode `yaml:"fileTransfer,omitempty" json:"fileTransfer"`
+	FileTransfer DockerFileMode `yaml:"fileTransfer,omitempty" json:"fileTransfer" enum:"mount,copy"`
 	Image        string         `yaml:"image,omitempty" json:"image"`
 }
 
@@ -109,7 +143,7 @@ type Npm struct {
 
 // Defaults represents default suite settings.
 type Defaults struct {
-	Mode string `yaml:"mode,omitempty" json:"mode"`
+	Mode string `yaml:"mode,omitempty" json:"mode" enum:"docker,sauce"`
 }
 
 // Version* contains referenced config version
@@ -127,6 +161,41 @@ const (
 	KindXcuitest   = "xcuitest"
 )
 
+// AllowUnknownKeys disables the strict decoding of config files, such that keys which saucectl doesn't know about are
+// ignored rather than rejected. Useful when a config is shared with a newer version of saucectl.
+var AllowUnknownKeys = false
+
+// unknownKeyRegex matches the error that the yaml decoder reports for an unknown key in strict mode.
+var unknownKeyRegex = regexp.MustCompile(`^line (\d+): field (.+) not found in type \S+$`)
+
+// Decode decodes the yaml config read from r into v. Unless AllowUnknownKeys is set, keys that don't correspond to any
+// field of v are rejected, since they are most likely typos.
+func Decode(r io.Reader, v interface{}) error {
+	d := yaml.NewDecoder(r)
+	d.SetStrict(!AllowUnknownKeys)
+
+	err := d.Decode(v)
+	var te *yaml.TypeError
+	if !errors.As(err, &te) {
+		return err
+	}
+
+	unknown := false
+	msgs := make([]string, len(te.Errors))
+	for i, e := range te.Errors {
+		msgs[i] = e
+		if m := unknownKeyRegex.FindStringSubmatch(e); m != nil {
+			msgs[i] = fmt.Sprintf("line %s: unknown key '%s'", m[1], m[2])
+			unknown = true
+		}
+	}
+	msg := strings.Join(msgs, "\n  ")
+	if unknown {
+		msg += "\n(use --allow-unknown-keys to ignore unknown keys)"
+	}
+	return fmt.Errorf("invalid config:\n  %s", msg)
+}
+
 func readYaml(cfgFilePath string) ([]byte, error) {
 	if cfgFilePath == "" {
 		return nil, errors.New("no config file was provided")
@@ -142,7 +211,7 @@ func readYaml(cfgFilePath string) ([]byte, error) {
 		fp = filepath.Join(pwd, cfgFilePath)
 	}
 
-	return os.ReadFile(fp)
+	return Resolve(fp)
 }
 
 // Describe returns a description of the given config that is cfgPath.
@@ -185,3 +254,12 @@ func StandardizeVersionFormat(version string) string {
 	}
 	return version
 }
+
+// SuiteRetries returns how often a suite whose retries setting is retries is re-run. A suite that doesn't set its
+// retries, which the run command otherwise defaults to sauce.retries, isn't re-run.
+func SuiteRetries(retries *int) int {
+	if retries == nil {
+		return 0
+	}
+	return *retries
+}
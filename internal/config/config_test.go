@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,3 +11,30 @@ func TestStandardizeVersionFormat(t *testing.T) {
 	assert.Equal(t, "5.6.0", StandardizeVersionFormat("v5.6.0"))
 	assert.Equal(t, "5.6.0", StandardizeVersionFormat("5.6.0"))
 }
+
+func TestDecode(t *testing.T) {
+	type sauce struct {
+		Region string `yaml:"region"`
+	}
+	type project struct {
+		Sauce sauce  `yaml:"sauce"`
+		Name  string `yaml:"name"`
+	}
+
+	doc := `name: test
+sauce:
+  region: us-west-1
+  regoin: eu-central-1
+`
+
+	var p project
+	err := Decode(strings.NewReader(doc), &p)
+	assert.EqualError(t, err, "invalid config:\n  line 4: unknown key 'regoin'\n(use --allow-unknown-keys to ignore unknown keys)")
+
+	AllowUnknownKeys = true
+	defer func() { AllowUnknownKeys = false }()
+
+	p = project{}
+	assert.NoError(t, Decode(strings.NewReader(doc), &p))
+	assert.Equal(t, project{Name: "test", Sauce: sauce{Region: "us-west-1"}}, p)
+}
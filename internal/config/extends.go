@@ -0,0 +1,237 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Keys that allow a config to be composed of multiple files.
+const (
+	// extendsKey references a base config, which the config is merged into.
+	extendsKey = "extends"
+	// includeKey references overlays, which are merged into the config in the given order.
+	includeKey = "include"
+)
+
+// Resolve reads the config file at cfgPath and resolves its 'extends' and 'include' references. Referenced files are
+// resolved relative to the file that references them. Their mappings are merged deeply, where values of the overlay
+// take precedence over those of the base, with the exception of 'suites', which are merged by name.
+// A config that doesn't reference any other files is returned as is.
+func Resolve(cfgPath string) ([]byte, error) {
+	b, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %v", cfgPath, err)
+	}
+	if !hasReferences(&doc) {
+		return b, nil
+	}
+
+	root, err := resolve(cfgPath, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(root); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resolve returns the root node of the config file at cfgPath, with all its references merged in. The stack of files
+// that are currently being resolved guards against cyclic references.
+func resolve(cfgPath string, stack []string) (*yamlv3.Node, error) {
+	abs, err := filepath.Abs(cfgPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stack {
+		if s == abs {
+			return nil, fmt.Errorf("cyclic reference to %s", cfgPath)
+		}
+	}
+	stack = append(stack, abs)
+
+	b, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return nil, err
+	}
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %v", cfgPath, err)
+	}
+	if len(doc.Content) == 0 {
+		return &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yamlv3.MappingNode {
+		return root, nil
+	}
+
+	extends, err := references(cfgPath, removeKey(root, extendsKey))
+	if err != nil {
+		return nil, err
+	}
+	includes, err := references(cfgPath, removeKey(root, includeKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var result *yamlv3.Node
+	for _, p := range extends {
+		base, err := resolve(p, stack)
+		if err != nil {
+			return nil, err
+		}
+		result = merge(result, base)
+	}
+	result = merge(result, root)
+	for _, p := range includes {
+		overlay, err := resolve(p, stack)
+		if err != nil {
+			return nil, err
+		}
+		result = merge(result, overlay)
+	}
+
+	return result, nil
+}
+
+// references returns the paths of the files referenced by n, which is either a single path or a list of paths,
+// relative to the file cfgPath.
+func references(cfgPath string, n *yamlv3.Node) ([]string, error) {
+	if n == nil {
+		return nil, nil
+	}
+
+	var paths []string
+	switch n.Kind {
+	case yamlv3.ScalarNode:
+		paths = []string{n.Value}
+	case yamlv3.SequenceNode:
+		for _, c := range n.Content {
+			if c.Kind != yamlv3.ScalarNode {
+				return nil, fmt.Errorf("%s:%d: expected a file path", cfgPath, c.Line)
+			}
+			paths = append(paths, c.Value)
+		}
+	default:
+		return nil, fmt.Errorf("%s:%d: expected a file path or a list of file paths", cfgPath, n.Line)
+	}
+
+	dir := filepath.Dir(cfgPath)
+	for i, p := range paths {
+		p = os.ExpandEnv(p)
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		paths[i] = p
+	}
+	return paths, nil
+}
+
+// merge deeply merges overlay into base and returns the result. Mappings are merged key by key, while any other value
+// of overlay replaces the one of base. The exception are suites, which are merged by name.
+func merge(base, overlay *yamlv3.Node) *yamlv3.Node {
+	if base == nil {
+		return overlay
+	}
+	if base.Kind != yamlv3.MappingNode || overlay.Kind != yamlv3.MappingNode {
+		return overlay
+	}
+
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		k, v := overlay.Content[i], overlay.Content[i+1]
+		bv := lookupKey(base, k.Value)
+		switch {
+		case bv == nil:
+			base.Content = append(base.Content, k, v)
+		case k.Value == "suites":
+			setKey(base, k.Value, mergeSuites(bv, v))
+		default:
+			setKey(base, k.Value, merge(bv, v))
+		}
+	}
+	return base
+}
+
+// mergeSuites merges the list of suites of overlay into those of base. Suites with the same name are merged, all
+// others are appended.
+func mergeSuites(base, overlay *yamlv3.Node) *yamlv3.Node {
+	if base.Kind != yamlv3.SequenceNode || overlay.Kind != yamlv3.SequenceNode {
+		return overlay
+	}
+
+	for _, s := range overlay.Content {
+		merged := false
+		if name := lookupKey(s, "name"); name != nil {
+			for i, bs := range base.Content {
+				if bn := lookupKey(bs, "name"); bn != nil && bn.Value == name.Value {
+					base.Content[i] = merge(bs, s)
+					merged = true
+					break
+				}
+			}
+		}
+		if !merged {
+			base.Content = append(base.Content, s)
+		}
+	}
+	return base
+}
+
+// hasReferences returns true if the document references any other config files.
+func hasReferences(doc *yamlv3.Node) bool {
+	if len(doc.Content) == 0 {
+		return false
+	}
+	root := doc.Content[0]
+	return lookupKey(root, extendsKey) != nil || lookupKey(root, includeKey) != nil
+}
+
+func lookupKey(n *yamlv3.Node, key string) *yamlv3.Node {
+	if n.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func setKey(n *yamlv3.Node, key string, v *yamlv3.Node) {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			n.Content[i+1] = v
+			return
+		}
+	}
+}
+
+// removeKey removes key from the mapping n and returns its value.
+func removeKey(n *yamlv3.Node, key string) *yamlv3.Node {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			v := n.Content[i+1]
+			n.Content = append(n.Content[:i], n.Content[i+2:]...)
+			return v
+		}
+	}
+	return nil
+}
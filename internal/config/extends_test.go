@@ -0,0 +1,84 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestResolve(t *testing.T) {
+	dir := fs.NewDir(t, "config-extends",
+		fs.WithDir("base", fs.WithFile("config.yml", `apiVersion: v1alpha
+kind: cypress
+sauce:
+  region: us-west-1
+  concurrency: 2
+  metadata:
+    tags: [base]
+suites:
+  - name: chrome
+    browser: chrome
+    config:
+      env:
+        A: "1"
+  - name: firefox
+    browser: firefox
+`)),
+		fs.WithFile("nightly.yml", `sauce:
+  metadata:
+    build: nightly
+`),
+		fs.WithFile("smoke.yml", `extends: base/config.yml
+include:
+  - nightly.yml
+sauce:
+  concurrency: 5
+  metadata:
+    build: smoke
+suites:
+  - name: chrome
+    config:
+      env:
+        B: "2"
+  - name: edge
+    browser: edge
+`),
+		fs.WithFile("plain.yml", "apiVersion: v1alpha\nkind:   cypress\n"),
+		fs.WithFile("cycle-a.yml", "extends: cycle-b.yml\n"),
+		fs.WithFile("cycle-b.yml", "extends: [cycle-a.yml]\n"),
+	)
+	defer dir.Remove()
+
+	b, err := Resolve(filepath.Join(dir.Path(), "smoke.yml"))
+	assert.NoError(t, err)
+	assert.Equal(t, `apiVersion: v1alpha
+kind: cypress
+sauce:
+  region: us-west-1
+  concurrency: 5
+  metadata:
+    tags: [base]
+    build: nightly
+suites:
+  - name: chrome
+    browser: chrome
+    config:
+      env:
+        A: "1"
+        B: "2"
+  - name: firefox
+    browser: firefox
+  - name: edge
+    browser: edge
+`, string(b))
+
+	// Configs without references are returned verbatim.
+	b, err = Resolve(filepath.Join(dir.Path(), "plain.yml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1alpha\nkind:   cypress\n", string(b))
+
+	_, err = Resolve(filepath.Join(dir.Path(), "cycle-a.yml"))
+	assert.EqualError(t, err, "cyclic reference to "+filepath.Join(dir.Path(), "cycle-a.yml"))
+}
@@ -1,17 +1,21 @@
 package cypress
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/rs/zerolog/log"
-	"gopkg.in/yaml.v2"
 
 	"github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/matrix"
+	"github.com/saucelabs/saucectl/internal/shard"
 )
 
 // Project represents the cypress project configuration.
@@ -29,17 +33,23 @@ type Project struct {
 	RootDir        string             `yaml:"rootDir,omitempty" json:"rootDir"`
 	RunnerVersion  string             `yaml:"runnerVersion,omitempty" json:"runnerVersion"`
 	Artifacts      config.Artifacts   `yaml:"artifacts,omitempty" json:"artifacts"`
+	Reporters      config.Reporters   `yaml:"reporters,omitempty" json:"-"`
 }
 
 // Suite represents the cypress test suite configuration.
 type Suite struct {
-	Name             string      `yaml:"name,omitempty" json:"name"`
-	Browser          string      `yaml:"browser,omitempty" json:"browser"`
-	BrowserVersion   string      `yaml:"browserVersion,omitempty" json:"browserVersion"`
-	PlatformName     string      `yaml:"platformName,omitempty" json:"platformName"`
-	Config           SuiteConfig `yaml:"config,omitempty" json:"config"`
-	ScreenResolution string      `yaml:"screenResolution,omitempty" json:"screenResolution"`
-	Mode             string      `yaml:"mode,omitempty" json:"-"`
+	Name             string        `yaml:"name,omitempty" json:"name"`
+	Browser          string        `yaml:"browser,omitempty" json:"browser"`
+	BrowserVersion   string        `yaml:"browserVersion,omitempty" json:"browserVersion"`
+	PlatformName     string        `yaml:"platformName,omitempty" json:"platformName"`
+	Config           SuiteConfig   `yaml:"config,omitempty" json:"config"`
+	ScreenResolution string        `yaml:"screenResolution,omitempty" json:"screenResolution"`
+	Mode             string        `yaml:"mode,omitempty" json:"-" enum:"docker,sauce"`
+	Retries          *int          `yaml:"retries,omitempty" json:"-"`
+	Shard            string        `yaml:"shard,omitempty" json:"-" enum:"spec,concurrency"`
+	Browsers         []string      `yaml:"browsers,omitempty" json:"-"`
+	Platforms        []string      `yaml:"platforms,omitempty" json:"-"`
+	Timeout          time.Duration `yaml:"timeout,omitempty" json:"-"`
 }
 
 // SuiteConfig represents the cypress config overrides.
@@ -74,16 +84,16 @@ type Cypress struct {
 func FromFile(cfgPath string) (Project, error) {
 	var p Project
 
-	f, err := os.Open(cfgPath)
+	b, err := config.Resolve(cfgPath)
 	if err != nil {
 		return Project{}, fmt.Errorf("failed to locate project config: %v", err)
 	}
-	defer f.Close()
 
-	if err := yaml.NewDecoder(f).Decode(&p); err != nil {
+	if err := config.Decode(bytes.NewReader(b), &p); err != nil {
 		return Project{}, fmt.Errorf("failed to parse project config: %v", err)
 	}
 	p.ConfigFilePath = cfgPath
+	ExpandSuites(&p)
 
 	p.Cypress.Key = os.ExpandEnv(p.Cypress.Key)
 
@@ -193,11 +203,36 @@ func Validate(p Project) error {
 		if len(s.Config.TestFiles) == 0 {
 			return fmt.Errorf("no config.testFiles specified in suite '%s'", s.Name)
 		}
+
+		if !shard.IsValid(s.Shard) {
+			return fmt.Errorf("illegal shard type '%s' in suite '%s', must be one of '%s'", s.Shard, s.Name,
+				strings.Join([]string{shard.Spec, shard.Concurrency}, "|"))
+		}
 	}
 
 	return nil
 }
 
+// ExpandSuites replaces each suite that lists multiple browsers or platforms with a suite per combination of them.
+func ExpandSuites(p *Project) {
+	var suites []Suite
+	matrix.Suites(len(p.Suites), func(i int) matrix.Suite {
+		s := p.Suites[i]
+		return matrix.Suite{Name: s.Name, Browser: s.Browser, Browsers: s.Browsers, Platform: s.PlatformName, Platforms: s.Platforms}
+	}, func(i int, c *matrix.Combination) {
+		s := p.Suites[i]
+		if c != nil {
+			s.Name = c.Name
+			s.Browser = c.Browser
+			s.PlatformName = c.Platform
+			s.Browsers = nil
+			s.Platforms = nil
+		}
+		suites = append(suites, s)
+	})
+	p.Suites = suites
+}
+
 // SplitSuites divided Suites to dockerSuites and sauceSuites
 func SplitSuites(p Project) (Project, Project) {
 	var dockerSuites []Suite
@@ -217,3 +252,53 @@ func SplitSuites(p Project) (Project, Project) {
 
 	return dockerProject, sauceProject
 }
+
+// ShardSuites replaces each suite that has sharding enabled with replicas of itself, each of which runs a subset of
+// the spec files that match config.testFiles of the original suite.
+func ShardSuites(p *Project, c *shard.Cache) error {
+	var suites []Suite
+	err := shard.Suites(len(p.Suites), p.Sauce.Concurrency, c, func(i int) (shard.Suite, error) {
+		s := p.Suites[i]
+		if s.Shard == "" {
+			return shard.Suite{}, nil
+		}
+		folder, err := integrationFolder(p.Cypress, p.RootDir)
+		return shard.Suite{Name: s.Name, Shard: s.Shard, Dir: folder, Patterns: s.Config.TestFiles,
+			PatternsKey: "config.testFiles"}, err
+	}, func(i int, r *shard.Replica) {
+		s := p.Suites[i]
+		if r != nil {
+			s.Name = r.Name
+			s.Config.TestFiles = r.Files
+		}
+		suites = append(suites, s)
+	})
+	if err != nil {
+		return err
+	}
+	p.Suites = suites
+
+	return nil
+}
+
+// integrationFolder returns the folder in which cypress looks for spec files, as configured in the cypress config
+// file.
+func integrationFolder(c Cypress, rootDir string) (string, error) {
+	cfgPath := filepath.Join(rootDir, c.ConfigFile)
+	b, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read cypress config: %v", err)
+	}
+
+	var cfg struct {
+		IntegrationFolder string `json:"integrationFolder"`
+	}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return "", fmt.Errorf("failed to parse cypress config: %v", err)
+	}
+	if cfg.IntegrationFolder == "" {
+		cfg.IntegrationFolder = filepath.Join("cypress", "integration")
+	}
+
+	return filepath.Join(filepath.Dir(cfgPath), cfg.IntegrationFolder), nil
+}
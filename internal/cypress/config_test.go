@@ -2,9 +2,12 @@ package cypress
 
 import (
 	"github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/shard"
+	"path/filepath"
 	"testing"
 	"errors"
 	"github.com/stretchr/testify/assert"
+	"gotest.tools/v3/fs"
 )
 
 func TestValidateThrowsErrors(t *testing.T) {
@@ -95,3 +98,87 @@ func TestValidateThrowsErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestShardSuites(t *testing.T) {
+	dir := fs.NewDir(t, "cypress-project",
+		fs.WithFile("cypress.json", `{"integrationFolder": "e2e"}`),
+		fs.WithDir("e2e",
+			fs.WithFile("a.spec.js", ""),
+			fs.WithFile("b.spec.js", ""),
+			fs.WithDir("nested", fs.WithFile("c.spec.js", "")),
+		),
+	)
+	defer dir.Remove()
+
+	testCases := []struct {
+		name      string
+		suite     Suite
+		wantNames []string
+		wantFiles [][]string
+		wantErr   bool
+	}{
+		{
+			name:      "no sharding",
+			suite:     Suite{Name: "suite", Config: SuiteConfig{TestFiles: []string{"**/*.js"}}},
+			wantNames: []string{"suite"},
+			wantFiles: [][]string{{"**/*.js"}},
+		},
+		{
+			name:      "shard by spec",
+			suite:     Suite{Name: "suite", Shard: shard.Spec, Config: SuiteConfig{TestFiles: []string{"**/*.js"}}},
+			wantNames: []string{"suite - a.spec.js", "suite - b.spec.js", "suite - nested/c.spec.js"},
+			wantFiles: [][]string{{"a.spec.js"}, {"b.spec.js"}, {"nested/c.spec.js"}},
+		},
+		{
+			name:      "shard by concurrency",
+			suite:     Suite{Name: "suite", Shard: shard.Concurrency, Config: SuiteConfig{TestFiles: []string{"**/*.js"}}},
+			wantNames: []string{"suite - 1/2", "suite - 2/2"},
+			wantFiles: [][]string{{"a.spec.js", "nested/c.spec.js"}, {"b.spec.js"}},
+		},
+		{
+			name:    "no matching files",
+			suite:   Suite{Name: "suite", Shard: shard.Spec, Config: SuiteConfig{TestFiles: []string{"**/*.ts"}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := Project{
+				RootDir: dir.Path(),
+				Cypress: Cypress{ConfigFile: "cypress.json"},
+				Sauce:   config.SauceConfig{Concurrency: 2},
+				Suites:  []Suite{tc.suite},
+			}
+			err := ShardSuites(&p, shard.NewCache(filepath.Join(t.TempDir(), "durations.json")))
+			if tc.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+
+			var names []string
+			var files [][]string
+			for _, s := range p.Suites {
+				names = append(names, s.Name)
+				files = append(files, s.Config.TestFiles)
+			}
+			assert.Equal(t, tc.wantNames, names)
+			assert.Equal(t, tc.wantFiles, files)
+		})
+	}
+}
+
+func TestExpandSuites(t *testing.T) {
+	p := Project{Suites: []Suite{
+		{Name: "single", Browser: "chrome"},
+		{Name: "matrix", Browser: "chrome", PlatformName: "Windows 10", Browsers: []string{"chrome", "firefox"}},
+	}}
+
+	ExpandSuites(&p)
+
+	assert.Equal(t, []Suite{
+		{Name: "single", Browser: "chrome"},
+		{Name: "matrix - chrome", Browser: "chrome", PlatformName: "Windows 10"},
+		{Name: "matrix - firefox", Browser: "firefox", PlatformName: "Windows 10"},
+	}, p.Suites)
+}
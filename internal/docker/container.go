@@ -6,10 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"github.com/saucelabs/saucectl/internal/report"
-	"github.com/saucelabs/saucectl/internal/report/table"
 	"io"
 	"os"
-	"os/signal"
 	"path"
 	"path/filepath"
 	"strings"
@@ -21,12 +19,15 @@ import (
 	"github.com/saucelabs/saucectl/internal/framework"
 	"github.com/saucelabs/saucectl/internal/job"
 	"github.com/saucelabs/saucectl/internal/jsonio"
+	"github.com/saucelabs/saucectl/internal/junit"
 	"github.com/saucelabs/saucectl/internal/progress"
 	"github.com/saucelabs/saucectl/internal/sauceignore"
 )
 
 // ContainerRunner represents the container runner for docker.
 type ContainerRunner struct {
+	// Ctx is the context of the run. Once it's done, e.g. because the user interrupted the run, suites that haven't
+	// started yet are skipped and those in progress are torn down.
 	Ctx               context.Context
 	docker            *Handler
 	containerConfig   *containerConfig
@@ -36,8 +37,9 @@ type ContainerRunner struct {
 	ShowConsoleLog    bool
 	JobReader         job.Reader
 	ArtfactDownloader download.ArtifactDownloader
-
-	interrupted bool
+	// Reporters receive the result of every suite. Rendering them is left to the caller, so that they can be shared
+	// with the runner of the suites that run on Sauce Labs.
+	Reporters []report.Reporter
 }
 
 // containerStartOptions represent data required to start a new container.
@@ -45,15 +47,21 @@ type containerStartOptions struct {
 	// DisplayName is used for local logging purposes only (e.g. console).
 	DisplayName string
 
-	Docker         config.Docker
-	BeforeExec     []string
-	Project        interface{}
-	SuiteName      string
-	Browser        string
-	Environment    map[string]string
-	RootDir        string
-	Sauceignore    string
+	Docker      config.Docker
+	BeforeExec  []string
+	Project     interface{}
+	SuiteName   string
+	Browser     string
+	Environment map[string]string
+	RootDir     string
+	Sauceignore string
+	// PreserveSymlinks copies symbolic links as links, rather than copying the files they point to.
+	PreserveSymlinks bool
+	// UseGitignore applies .gitignore files in addition to .sauceignore files.
+	UseGitignore   bool
 	ConfigFilePath string
+	Retries        int
+	Timeout        time.Duration
 }
 
 // result represents the result of a local job
@@ -62,11 +70,14 @@ type result struct {
 	err           error
 	passed        bool
 	skipped       bool
+	timedOut      bool
 	consoleOutput string
 	name          string
 	browser       string
 	duration      time.Duration
 	jobInfo       jobInfo
+	attempts      int
+	jobURLs       []string
 }
 
 // jobInfo represents the info on the job given by the container
@@ -163,7 +174,7 @@ func (r *ContainerRunner) startContainer(options containerStartOptions) (string,
 		return containerID, err
 	}
 
-	if err := r.docker.CopyToContainer(r.Ctx, containerID, rcPath, pDir, matcher); err != nil {
+	if err := r.docker.CopyToContainer(r.Ctx, containerID, rcPath, pDir, matcher, options.PreserveSymlinks); err != nil {
 		return containerID, err
 	}
 	r.containerConfig.sauceRunnerConfigPath = path.Join(pDir, SauceRunnerConfigFile)
@@ -177,8 +188,8 @@ func (r *ContainerRunner) startContainer(options containerStartOptions) (string,
 	return containerID, nil
 }
 
-func (r *ContainerRunner) run(containerID, suiteName string, cmd []string, env map[string]string) (output string, jobInfo jobInfo, passed bool, err error) {
-	exitCode, output, err := r.docker.ExecuteAttach(r.Ctx, containerID, cmd, env)
+func (r *ContainerRunner) run(ctx context.Context, containerID, suiteName string, cmd []string, env map[string]string) (output string, jobInfo jobInfo, passed bool, err error) {
+	exitCode, output, err := r.docker.ExecuteAttach(ctx, containerID, cmd, env)
 
 	if err != nil {
 		return "", jobInfo, false, err
@@ -254,7 +265,7 @@ func (r *ContainerRunner) createWorkerPool(ccy int) (chan containerStartOptions,
 
 func (r *ContainerRunner) runJobs(containerOpts <-chan containerStartOptions, results chan<- result) {
 	for opts := range containerOpts {
-		if r.interrupted {
+		if r.Ctx.Err() != nil {
 			results <- result{
 				name:    opts.DisplayName,
 				skipped: true,
@@ -262,7 +273,26 @@ func (r *ContainerRunner) runJobs(containerOpts <-chan containerStartOptions, re
 			continue
 		}
 		start := time.Now()
-		containerID, output, jobDetails, passed, skipped, err := r.runSuite(opts)
+		var containerID, output string
+		var jobDetails jobInfo
+		var passed, skipped, timedOut bool
+		var err error
+		var jobURLs []string
+		attempts := 0
+		for attempts <= opts.Retries {
+			if attempts > 0 {
+				log.Warn().Err(err).Str("suite", opts.DisplayName).Int("attempt", attempts+1).Msg("Retrying suite.")
+			}
+
+			attempts++
+			containerID, output, jobDetails, passed, skipped, timedOut, err = r.runSuite(opts)
+			if jobDetails.JobDetailsURL != "" && jobDetails.JobDetailsURL != "unknown" {
+				jobURLs = append(jobURLs, jobDetails.JobDetailsURL)
+			}
+			if passed || skipped || r.Ctx.Err() != nil {
+				break
+			}
+		}
 		results <- result{
 			name:          opts.DisplayName,
 			containerID:   containerID,
@@ -270,9 +300,12 @@ func (r *ContainerRunner) runJobs(containerOpts <-chan containerStartOptions, re
 			jobInfo:       jobDetails,
 			passed:        passed,
 			skipped:       skipped,
+			timedOut:      timedOut,
 			consoleOutput: output,
 			duration:      time.Since(start),
 			err:           err,
+			attempts:      attempts,
+			jobURLs:       jobURLs,
 		}
 	}
 }
@@ -283,11 +316,6 @@ func (r *ContainerRunner) collectResults(artifactCfg config.ArtifactDownload, re
 	inProgress := expected
 	passed := true
 
-	reporter := table.Reporter{
-		TestResults: make([]report.TestResult, 0, expected),
-		Dst:         os.Stdout,
-	}
-
 	done := make(chan interface{})
 	go func() {
 		t := time.NewTicker(10 * time.Second)
@@ -307,33 +335,70 @@ func (r *ContainerRunner) collectResults(artifactCfg config.ArtifactDownload, re
 		inProgress--
 
 		jobID := getJobID(res.jobInfo.JobDetailsURL)
-		if download.ShouldDownloadArtifact(jobID, res.passed, artifactCfg) {
-			r.ArtfactDownloader.DownloadArtifact(jobID)
+		if !res.skipped && download.ShouldDownloadArtifact(jobID, res.passed, artifactCfg) {
+			ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+			if err := r.ArtfactDownloader.DownloadArtifact(ctx, jobID); err != nil {
+				log.Error().Err(err).Str("suite", res.name).Msg("Failed to download artifacts.")
+			}
+			cancel()
 		}
 
 		if !res.passed {
 			passed = false
 		}
 
+		tr := report.TestResult{
+			Name:     res.name,
+			Duration: res.duration,
+			Passed:   res.passed,
+			Skipped:  res.skipped,
+			TimedOut: res.timedOut,
+			Browser:  res.browser,
+			Platform: "Docker",
+			Attempts: res.attempts,
+			URLs:     res.jobURLs,
+		}
+		if res.jobInfo.JobDetailsURL != "" && res.jobInfo.JobDetailsURL != "unknown" {
+			tr.JobID = jobID
+			tr.URL = res.jobInfo.JobDetailsURL
+		}
+		if res.err != nil {
+			tr.Error = res.err.Error()
+		}
 		if !res.skipped {
-			reporter.Add(report.TestResult{
-				Name:     res.name,
-				Duration: res.duration,
-				Passed:   res.passed,
-				Browser:  res.browser,
-				Platform: "Docker",
-			})
+			tr.Artifacts = r.loadArtifacts(jobID, res.name)
+		}
+		for _, rep := range r.Reporters {
+			rep.Add(tr)
 		}
 
 		r.logSuite(res)
 	}
 	close(done)
 
-	reporter.Render()
-
 	return passed
 }
 
+// loadArtifacts fetches the job assets that are required by the configured reporters.
+func (r *ContainerRunner) loadArtifacts(jobID, suiteName string) []report.Artifact {
+	var artifacts []report.Artifact
+	if r.JobReader == nil || jobID == "" || jobID == "unknown" ||
+		!report.IsArtifactRequired(r.Reporters, report.JUnitArtifact) {
+		return artifacts
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	content, err := r.JobReader.GetJobAssetFileContent(ctx, jobID, junit.FileName)
+	if err != nil {
+		log.Warn().Err(err).Str("suite", suiteName).Msg("Failed to retrieve the junit report.")
+		return artifacts
+	}
+
+	return append(artifacts, report.Artifact{AssetType: report.JUnitArtifact, Body: content})
+}
+
 func getJobID(jobURL string) string {
 	details := strings.Split(jobURL, "/")
 	return details[len(details)-1]
@@ -349,7 +414,9 @@ func (r *ContainerRunner) logSuite(res result) {
 		return
 	}
 
-	if res.passed {
+	if res.timedOut {
+		log.Error().Str("url", res.jobInfo.JobDetailsURL).Str("suite", res.name).Msg("Suite timed out.")
+	} else if res.passed {
 		log.Info().Bool("passed", res.passed).Str("url", res.jobInfo.JobDetailsURL).Str("suite", res.name).Msg("Suite finished.")
 		if !res.jobInfo.ReportingSucceeded {
 			log.Warn().Str("suite", res.name).Msg("Reporting results to Sauce Labs failed.")
@@ -364,29 +431,45 @@ func (r *ContainerRunner) logSuite(res result) {
 }
 
 // runSuite runs the selected suite.
-func (r *ContainerRunner) runSuite(options containerStartOptions) (containerID string, output string, jobInfo jobInfo, passed bool, skipped bool, err error) {
+func (r *ContainerRunner) runSuite(options containerStartOptions) (containerID string, output string, jobInfo jobInfo, passed bool, skipped bool, timedOut bool, err error) {
 	log.Info().Str("suite", options.DisplayName).Msg("Setting up test environment")
 	containerID, err = r.startContainer(options)
 	defer r.tearDown(containerID, options.SuiteName)
 
-	// os.Interrupt can arrive before the signal.Notify() is registered. In that case,
-	// if a soft exit is requested during startContainer phase, it gently exits.
-	if r.interrupted {
+	// The run may have been interrupted while the container was starting.
+	if r.Ctx.Err() != nil {
 		skipped = true
 		return
 	}
 
-	sigC := r.registerInterruptOnSignal(containerID, options.SuiteName, &skipped)
-	defer unregisterSignalCapture(sigC)
-
 	if err != nil {
 		log.Err(err).Str("suite", options.DisplayName).Msg("Failed to setup test environment")
 		return
 	}
 
-	output, jobInfo, passed, err = r.run(containerID, options.SuiteName,
+	ctx := r.Ctx
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(r.Ctx, options.Timeout)
+		defer cancel()
+	}
+
+	output, jobInfo, passed, err = r.run(ctx, containerID, options.SuiteName,
 		[]string{"npm", "test", "--", "-r", r.containerConfig.sauceRunnerConfigPath, "-s", options.SuiteName},
 		options.Environment)
+	if r.Ctx.Err() != nil {
+		log.Info().Str("suite", options.DisplayName).Msg("Interrupting suite")
+		skipped = true
+		return
+	}
+	// The container is stopped by tearDown.
+	if ctx.Err() != nil {
+		log.Warn().Str("suite", options.DisplayName).Dur("timeout", options.Timeout).Msg("Suite timed out.")
+		timedOut = true
+		passed = false
+		err = fmt.Errorf("suite '%s' has timed out after %s", options.DisplayName, options.Timeout)
+		return
+	}
 
 	jobID := jobIDFromURL(jobIDFromURL(jobInfo.JobDetailsURL))
 	if jobID != "" {
@@ -422,57 +505,16 @@ func jobIDFromURL(URL string) string {
 	return ID
 }
 
-// registerInterruptOnSignal runs tearDown on SIGINT / Interrupt.
-func (r *ContainerRunner) registerInterruptOnSignal(containerID, suiteName string, interrupted *bool) chan os.Signal {
-	sigChan := make(chan os.Signal)
-	signal.Notify(sigChan, os.Interrupt)
-
-	go func(c <-chan os.Signal, interrupted *bool, containerID, suiteName string) {
-		sig := <-c
-		if sig == nil {
-			return
-		}
-		log.Info().Str("suite", suiteName).Msg("Interrupting suite")
-		*interrupted = true
-		r.tearDown(containerID, suiteName)
-	}(sigChan, interrupted, containerID, suiteName)
-	return sigChan
-}
-
-// registerSkipSuitesOnSignal prevent new suites from being executed when a SIGINT is captured.
-func (r *ContainerRunner) registerSkipSuitesOnSignal() chan os.Signal {
-	sigChan := make(chan os.Signal)
-	signal.Notify(sigChan, os.Interrupt)
-
-	go func(c <-chan os.Signal, cr *ContainerRunner) {
-		for {
-			sig := <-c
-			if sig == nil {
-				return
-			}
-			if cr.interrupted {
-				os.Exit(1)
-			}
-			log.Info().Msg("Ctrl-C captured. Ctrl-C again to exit now.")
-			cr.interrupted = true
-		}
-	}(sigChan, r)
-	return sigChan
-}
-
-// unregisterSignalCapture remove the signal hook associated to the chan c.
-func unregisterSignalCapture(c chan os.Signal) {
-	signal.Stop(c)
-	close(c)
-}
-
-// tearDown stops the test environment and remove docker containers.
+// tearDown stops the test environment and remove docker containers. Since the environment has to be torn down even if
+// the run has been interrupted, it doesn't use the context of the run.
 func (r *ContainerRunner) tearDown(containerID, suiteName string) {
 	if containerID == "" {
 		return
 	}
 	log.Info().Str("suite", suiteName).Msg("Tearing down environment")
-	if err := r.docker.Teardown(r.Ctx, containerID); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), teardownTimeout)
+	defer cancel()
+	if err := r.docker.Teardown(ctx, containerID); err != nil {
 		if !r.docker.IsErrNotFound(err) && !r.docker.IsErrRemovalInProgress(err) {
 			log.Error().Err(err).Str("suite", suiteName).Msg("Failed to tear down environment")
 		}
@@ -36,7 +36,33 @@ func TestPullImage(t *testing.T) {
 	assert.Equal(t, err.Error(), "ImagePullFailure")
 }
 
-func ExampleGetJobID() {
+func TestRunJobsRetries_AllFail(t *testing.T) {
+	r := &ContainerRunner{
+		Ctx:    context.Background(),
+		docker: &Handler{client: &mocks.FakeClient{}},
+	}
+
+	opts := make(chan containerStartOptions)
+	results := make(chan result, 2)
+
+	go r.runJobs(opts, results)
+	opts <- containerStartOptions{DisplayName: "dummy", Retries: 2}
+	opts <- containerStartOptions{DisplayName: "no retries"}
+	close(opts)
+
+	res := <-results
+	assert.False(t, res.passed)
+	assert.NotNil(t, res.err)
+	assert.Equal(t, 3, res.attempts)
+	assert.Len(t, res.jobURLs, 0)
+
+	res = <-results
+	assert.False(t, res.passed)
+	assert.Equal(t, 1, res.attempts)
+	assert.Len(t, res.jobURLs, 0)
+}
+
+func Example_getJobID() {
 	fmt.Println(getJobID("https://app.saucelabs.com/tests/cb6741a1a119448a9760531024657967"))
 	// Output: cb6741a1a119448a9760531024657967
 }
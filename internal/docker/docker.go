@@ -35,7 +35,11 @@ import (
 )
 
 var (
-	containerStopTimeout   = time.Duration(10) * time.Second
+	containerStopTimeout = time.Duration(10) * time.Second
+	// teardownTimeout is how long stopping and removing a container may take in total.
+	teardownTimeout = time.Duration(30) * time.Second
+	// fetchTimeout is how long fetching the assets of a finished container may take, even once the run is interrupted.
+	fetchTimeout           = time.Duration(5) * time.Minute
 	containerRemoveOptions = types.ContainerRemoveOptions{
 		Force:         true,
 		RemoveLinks:   false,
@@ -254,11 +258,12 @@ func (handler *Handler) StartContainer(ctx context.Context, options containerSta
 	}
 
 	if options.Docker.FileTransfer == config.DockerFileCopy {
-		matcher, err := sauceignore.NewMatcherFromFile(options.Sauceignore)
+		matcher, err := sauceignore.NewMatcherFromDir(options.RootDir, options.Sauceignore,
+			sauceignore.Options{UseGitignore: options.UseGitignore})
 		if err != nil {
 			return nil, err
 		}
-		if err := copyTestFiles(ctx, handler, container.ID, options.SuiteName, options.RootDir, pDir, matcher); err != nil {
+		if err := copyTestFiles(ctx, handler, container.ID, options.SuiteName, options.RootDir, pDir, matcher, options.PreserveSymlinks); err != nil {
 			return nil, err
 		}
 	}
@@ -276,9 +281,9 @@ func (handler *Handler) StartContainer(ctx context.Context, options containerSta
 
 // copyTestFiles copies the files within the container.
 func copyTestFiles(ctx context.Context, handler *Handler, containerID, suiteName string, projectFolder string, pDir string,
-	matcher sauceignore.Matcher) error {
+	matcher sauceignore.Matcher, preserveSymlinks bool) error {
 
-	if err := handler.CopyToContainer(ctx, containerID, projectFolder, pDir, matcher); err != nil {
+	if err := handler.CopyToContainer(ctx, containerID, projectFolder, pDir, matcher, preserveSymlinks); err != nil {
 		return err
 	}
 	log.Info().Str("from", projectFolder).Str("to", pDir).Str("suite", suiteName).Msg("File copied")
@@ -316,11 +321,12 @@ func createMounts(suiteName string, files []string, target string) ([]mount.Moun
 
 // CopyToContainer copies the given file to the container.
 func (handler *Handler) CopyToContainer(ctx context.Context, containerID string, srcFile string, targetDir string,
-	matcher sauceignore.Matcher) error {
-	tarReader, err := tar.Archive(srcFile, matcher, tar.Options{Permission: &defaultArchivePermissions})
+	matcher sauceignore.Matcher, preserveSymlinks bool) error {
+	tarReader, err := tar.Archive(srcFile, matcher, tar.Options{Permission: &defaultArchivePermissions, PreserveSymlinks: preserveSymlinks})
 	if err != nil {
 		return err
 	}
+	defer tarReader.Close()
 
 	return handler.client.CopyToContainer(ctx, containerID, targetDir, tarReader, types.CopyToContainerOptions{})
 }
@@ -3,10 +3,12 @@ package docker
 import (
 	"context"
 
+	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/download"
 	"github.com/saucelabs/saucectl/internal/framework"
 	"github.com/saucelabs/saucectl/internal/job"
 	"github.com/saucelabs/saucectl/internal/playwright"
+	"github.com/saucelabs/saucectl/internal/report"
 )
 
 // PlaywrightRunner represents the docker implementation of a test runner.
@@ -16,11 +18,11 @@ type PlaywrightRunner struct {
 }
 
 // NewPlaywright creates a new PlaywrightRunner instance.
-func NewPlaywright(c playwright.Project, ms framework.MetadataService, wr job.Writer, dl download.ArtifactDownloader) (*PlaywrightRunner, error) {
+func NewPlaywright(ctx context.Context, c playwright.Project, ms framework.MetadataService, wr job.Writer, jr job.Reader, dl download.ArtifactDownloader, reps []report.Reporter) (*PlaywrightRunner, error) {
 	r := PlaywrightRunner{
 		Project: c,
 		ContainerRunner: ContainerRunner{
-			Ctx:             context.Background(),
+			Ctx:             ctx,
 			docker:          nil,
 			containerConfig: &containerConfig{},
 			Framework: framework.Framework{
@@ -30,7 +32,9 @@ func NewPlaywright(c playwright.Project, ms framework.MetadataService, wr job.Wr
 			FrameworkMeta:     ms,
 			ShowConsoleLog:    c.ShowConsoleLog,
 			JobWriter:         wr,
+			JobReader:         jr,
 			ArtfactDownloader: dl,
+			Reporters:         reps,
 		},
 	}
 
@@ -51,25 +55,26 @@ func (r *PlaywrightRunner) RunProject() (int, error) {
 		return 1, err
 	}
 
-	sigChan := r.registerSkipSuitesOnSignal()
-	defer unregisterSignalCapture(sigChan)
-
 	containerOpts, results := r.createWorkerPool(r.Project.Sauce.Concurrency)
 	defer close(results)
 
 	go func() {
 		for _, suite := range r.Project.Suites {
 			containerOpts <- containerStartOptions{
-				Docker:         r.Project.Docker,
-				BeforeExec:     r.Project.BeforeExec,
-				Project:        r.Project,
-				Browser:        suite.Params.BrowserName,
-				DisplayName:    suite.Name,
-				SuiteName:      suite.Name,
-				Environment:    suite.Env,
-				RootDir:        r.Project.RootDir,
-				Sauceignore:    r.Project.Sauce.Sauceignore,
-				ConfigFilePath: r.Project.ConfigFilePath,
+				Docker:           r.Project.Docker,
+				BeforeExec:       r.Project.BeforeExec,
+				Project:          r.Project,
+				Browser:          suite.Params.BrowserName,
+				DisplayName:      suite.Name,
+				SuiteName:        suite.Name,
+				Environment:      suite.Env,
+				RootDir:          r.Project.RootDir,
+				Sauceignore:      r.Project.Sauce.Sauceignore,
+				PreserveSymlinks: r.Project.Sauce.PreserveSymlinks,
+				UseGitignore:     r.Project.Sauce.UseGitignore,
+				ConfigFilePath:   r.Project.ConfigFilePath,
+				Retries:          config.SuiteRetries(suite.Retries),
+				Timeout:          suite.Timeout,
 			}
 		}
 		close(containerOpts)
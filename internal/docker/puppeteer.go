@@ -3,10 +3,12 @@ package docker
 import (
 	"context"
 
+	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/download"
 	"github.com/saucelabs/saucectl/internal/framework"
 	"github.com/saucelabs/saucectl/internal/job"
 	"github.com/saucelabs/saucectl/internal/puppeteer"
+	"github.com/saucelabs/saucectl/internal/report"
 )
 
 // PuppeterRunner represents the docker implementation of a test runner.
@@ -16,11 +18,11 @@ type PuppeterRunner struct {
 }
 
 // NewPuppeteer creates a new PuppeterRunner instance.
-func NewPuppeteer(c puppeteer.Project, ms framework.MetadataService, wr job.Writer, dl download.ArtifactDownloader) (*PuppeterRunner, error) {
+func NewPuppeteer(ctx context.Context, c puppeteer.Project, ms framework.MetadataService, wr job.Writer, jr job.Reader, dl download.ArtifactDownloader, reps []report.Reporter) (*PuppeterRunner, error) {
 	r := PuppeterRunner{
 		Project: c,
 		ContainerRunner: ContainerRunner{
-			Ctx:             context.Background(),
+			Ctx:             ctx,
 			containerConfig: &containerConfig{},
 			Framework: framework.Framework{
 				Name:    c.Kind,
@@ -29,7 +31,9 @@ func NewPuppeteer(c puppeteer.Project, ms framework.MetadataService, wr job.Writ
 			FrameworkMeta:     ms,
 			ShowConsoleLog:    c.ShowConsoleLog,
 			JobWriter:         wr,
+			JobReader:         jr,
 			ArtfactDownloader: dl,
+			Reporters:         reps,
 		},
 	}
 	var err error
@@ -49,25 +53,26 @@ func (r *PuppeterRunner) RunProject() (int, error) {
 		return 1, err
 	}
 
-	sigChan := r.registerSkipSuitesOnSignal()
-	defer unregisterSignalCapture(sigChan)
-
 	containerOpts, results := r.createWorkerPool(r.Project.Sauce.Concurrency)
 	defer close(results)
 
 	go func() {
 		for _, suite := range r.Project.Suites {
 			containerOpts <- containerStartOptions{
-				Docker:         r.Project.Docker,
-				BeforeExec:     r.Project.BeforeExec,
-				Project:        r.Project,
-				Browser:        suite.Browser,
-				DisplayName:    suite.Name,
-				SuiteName:      suite.Name,
-				Environment:    suite.Env,
-				RootDir:        r.Project.RootDir,
-				Sauceignore:    r.Project.Sauce.Sauceignore,
-				ConfigFilePath: r.Project.ConfigFilePath,
+				Docker:           r.Project.Docker,
+				BeforeExec:       r.Project.BeforeExec,
+				Project:          r.Project,
+				Browser:          suite.Browser,
+				DisplayName:      suite.Name,
+				SuiteName:        suite.Name,
+				Environment:      suite.Env,
+				RootDir:          r.Project.RootDir,
+				Sauceignore:      r.Project.Sauce.Sauceignore,
+				PreserveSymlinks: r.Project.Sauce.PreserveSymlinks,
+				UseGitignore:     r.Project.Sauce.UseGitignore,
+				ConfigFilePath:   r.Project.ConfigFilePath,
+				Retries:          config.SuiteRetries(suite.Retries),
+				Timeout:          suite.Timeout,
 			}
 		}
 		close(containerOpts)
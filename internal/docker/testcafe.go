@@ -3,9 +3,11 @@ package docker
 import (
 	"context"
 
+	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/download"
 	"github.com/saucelabs/saucectl/internal/framework"
 	"github.com/saucelabs/saucectl/internal/job"
+	"github.com/saucelabs/saucectl/internal/report"
 	"github.com/saucelabs/saucectl/internal/testcafe"
 )
 
@@ -16,11 +18,11 @@ type TestcafeRunner struct {
 }
 
 // NewTestcafe creates a new TestcafeRunner instance.
-func NewTestcafe(c testcafe.Project, ms framework.MetadataService, wr job.Writer, dl download.ArtifactDownloader) (*TestcafeRunner, error) {
+func NewTestcafe(ctx context.Context, c testcafe.Project, ms framework.MetadataService, wr job.Writer, jr job.Reader, dl download.ArtifactDownloader, reps []report.Reporter) (*TestcafeRunner, error) {
 	r := TestcafeRunner{
 		Project: c,
 		ContainerRunner: ContainerRunner{
-			Ctx:             context.Background(),
+			Ctx:             ctx,
 			containerConfig: &containerConfig{},
 			Framework: framework.Framework{
 				Name:    c.Kind,
@@ -29,7 +31,9 @@ func NewTestcafe(c testcafe.Project, ms framework.MetadataService, wr job.Writer
 			FrameworkMeta:     ms,
 			ShowConsoleLog:    c.ShowConsoleLog,
 			JobWriter:         wr,
+			JobReader:         jr,
 			ArtfactDownloader: dl,
+			Reporters:         reps,
 		},
 	}
 	var err error
@@ -49,25 +53,26 @@ func (r *TestcafeRunner) RunProject() (int, error) {
 		return 1, err
 	}
 
-	sigChan := r.registerSkipSuitesOnSignal()
-	defer unregisterSignalCapture(sigChan)
-
 	containerOpts, results := r.createWorkerPool(r.Project.Sauce.Concurrency)
 	defer close(results)
 
 	go func() {
 		for _, suite := range r.Project.Suites {
 			containerOpts <- containerStartOptions{
-				Docker:         r.Project.Docker,
-				BeforeExec:     r.Project.BeforeExec,
-				Project:        r.Project,
-				Browser:        suite.BrowserName,
-				DisplayName:    suite.Name,
-				SuiteName:      suite.Name,
-				Environment:    suite.Env,
-				RootDir:        r.Project.RootDir,
-				Sauceignore:    r.Project.Sauce.Sauceignore,
-				ConfigFilePath: r.Project.ConfigFilePath,
+				Docker:           r.Project.Docker,
+				BeforeExec:       r.Project.BeforeExec,
+				Project:          r.Project,
+				Browser:          suite.BrowserName,
+				DisplayName:      suite.Name,
+				SuiteName:        suite.Name,
+				Environment:      suite.Env,
+				RootDir:          r.Project.RootDir,
+				Sauceignore:      r.Project.Sauce.Sauceignore,
+				PreserveSymlinks: r.Project.Sauce.PreserveSymlinks,
+				UseGitignore:     r.Project.Sauce.UseGitignore,
+				ConfigFilePath:   r.Project.ConfigFilePath,
+				Retries:          config.SuiteRetries(suite.Retries),
+				Timeout:          suite.Timeout,
 			}
 		}
 		close(containerOpts)
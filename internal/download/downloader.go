@@ -1,6 +1,8 @@
 package download
 
+import "context"
+
 // ArtifactDownloader defines download functions
 type ArtifactDownloader interface {
-	DownloadArtifact(jobID string)
+	DownloadArtifact(ctx context.Context, jobID string) error
 }
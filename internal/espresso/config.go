@@ -1,13 +1,15 @@
 package espresso
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/saucelabs/saucectl/internal/config"
-	"gopkg.in/yaml.v2"
+	"github.com/saucelabs/saucectl/internal/storage"
 )
 
 // Project represents the espresso project configuration.
@@ -18,6 +20,7 @@ type Project struct {
 	Espresso       Espresso           `yaml:"espresso,omitempty" json:"espresso"`
 	Suites         []Suite            `yaml:"suites,omitempty" json:"suites"`
 	Artifacts      config.Artifacts   `yaml:"artifacts,omitempty" json:"artifacts"`
+	Reporters      config.Reporters   `yaml:"reporters,omitempty" json:"-"`
 }
 
 // Espresso represents espresso apps configuration.
@@ -44,6 +47,8 @@ type Suite struct {
 	Devices     []config.Device   `yaml:"devices,omitempty" json:"devices"`
 	Emulators   []config.Emulator `yaml:"emulators,omitempty" json:"emulators"`
 	TestOptions TestOptions       `yaml:"testOptions,omitempty" json:"testOptions"`
+	Retries     *int              `yaml:"retries,omitempty" json:"-"`
+	Timeout     time.Duration     `yaml:"timeout,omitempty" json:"-"`
 }
 
 // Android constant
@@ -53,13 +58,12 @@ const Android = "Android"
 func FromFile(cfgPath string) (Project, error) {
 	var p Project
 
-	f, err := os.Open(cfgPath)
+	b, err := config.Resolve(cfgPath)
 	if err != nil {
 		return Project{}, fmt.Errorf("failed to locate project config: %v", err)
 	}
-	defer f.Close()
 
-	if err := yaml.NewDecoder(f).Decode(&p); err != nil {
+	if err := config.Decode(bytes.NewReader(b), &p); err != nil {
 		return Project{}, fmt.Errorf("failed to parse project config: %v", err)
 	}
 	p.ConfigFilePath = cfgPath
@@ -92,14 +96,14 @@ func Validate(p Project) error {
 	if p.Espresso.App == "" {
 		return errors.New("missing path to app .apk")
 	}
-	if !strings.HasSuffix(p.Espresso.App, ".apk") {
+	if !storage.IsReference(p.Espresso.App) && !strings.HasSuffix(p.Espresso.App, ".apk") {
 		return fmt.Errorf("invaild application file: %s, make sure extension is .apk", p.Espresso.App)
 	}
 
 	if p.Espresso.TestApp == "" {
 		return errors.New("missing path to test app .apk")
 	}
-	if !strings.HasSuffix(p.Espresso.TestApp, ".apk") {
+	if !storage.IsReference(p.Espresso.TestApp) && !strings.HasSuffix(p.Espresso.TestApp, ".apk") {
 		return fmt.Errorf("invaild test application file: %s, make sure extension is .apk", p.Espresso.TestApp)
 	}
 
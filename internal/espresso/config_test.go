@@ -59,6 +59,16 @@ func TestValidateThrowsErrors(t *testing.T) {
 			},
 			expectedErr: errors.New("no suites defined"),
 		},
+		{
+			name: "validating accepts storage references as apps",
+			p: &Project{
+				Espresso: Espresso{
+					App:     "storage:c78ec45e-ea3e-ac6a-b094-00364171e2e4",
+					TestApp: "storage:filename=testApp.apk",
+				},
+			},
+			expectedErr: errors.New("no suites defined"),
+		},
 		{
 			name: "validating throws error on missing devices",
 			p: &Project{
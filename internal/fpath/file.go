@@ -1,10 +1,12 @@
 package fpath
 
 import (
+	"fmt"
 	"github.com/rs/zerolog/log"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 )
 
 // Globs returns the names of all files matching the glob patterns.
@@ -77,6 +79,114 @@ func List(dir string, pattern string) ([]string, error) {
 	return ll, err
 }
 
+// FindFiles returns the paths, relative to sourceDir, of all files in sourceDir that match any of the glob patterns.
+// Patterns use the syntax of filepath.Match and are matched against the slash separated relative path. In addition,
+// a '**' path element matches any number of directories and '{a,b}' matches either alternative. Just like test
+// frameworks do, node_modules are skipped.
+func FindFiles(sourceDir string, patterns []string) ([]string, error) {
+	var files []string
+
+	var globs [][]string
+	for _, p := range patterns {
+		for _, alt := range expandBraces(filepath.ToSlash(p)) {
+			// Validate the pattern upfront, since filepath.Match only reports malformed patterns on a mismatch.
+			if _, err := filepath.Match(alt, ""); err != nil {
+				return files, fmt.Errorf("invalid pattern '%s': %w", p, err)
+			}
+			globs = append(globs, strings.Split(alt, "/"))
+		}
+	}
+
+	err := filepath.Walk(sourceDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == "node_modules" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		elems := strings.Split(rel, "/")
+		for _, g := range globs {
+			if matchElems(g, elems) {
+				files = append(files, rel)
+				break
+			}
+		}
+		return nil
+	})
+
+	return files, err
+}
+
+// matchElems reports whether the path elements match the pattern elements, where a '**' pattern element matches
+// any number of path elements.
+func matchElems(pattern []string, elems []string) bool {
+	if len(pattern) == 0 {
+		return len(elems) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(elems); i++ {
+			if matchElems(pattern[1:], elems[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(elems) == 0 {
+		return false
+	}
+	if ok, _ := filepath.Match(pattern[0], elems[0]); !ok {
+		return false
+	}
+	return matchElems(pattern[1:], elems[1:])
+}
+
+// expandBraces returns all variants of pattern that result from choosing one alternative of each '{a,b}' group.
+func expandBraces(pattern string) []string {
+	start := strings.Index(pattern, "{")
+	if start < 0 {
+		return []string{pattern}
+	}
+
+	// Find the matching closing brace, as well as the commas that separate the alternatives at the top level.
+	depth := 0
+	commas := []int{start}
+	for i := start + 1; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '{':
+			depth++
+		case ',':
+			if depth == 0 {
+				commas = append(commas, i)
+			}
+		case '}':
+			if depth > 0 {
+				depth--
+				continue
+			}
+			var expanded []string
+			commas = append(commas, i)
+			for j := 0; j < len(commas)-1; j++ {
+				alt := pattern[:start] + pattern[commas[j]+1:commas[j+1]] + pattern[i+1:]
+				expanded = append(expanded, expandBraces(alt)...)
+			}
+			return expanded
+		}
+	}
+
+	// Unbalanced braces are matched literally.
+	return []string{pattern}
+}
+
 // DeepCopy performs a deep copy of src to target, creating all folders leading up to target if necessary.
 func DeepCopy(src string, target string) error {
 	prefix := filepath.Dir(target)
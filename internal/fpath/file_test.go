@@ -191,3 +191,78 @@ func TestWalk(t *testing.T) {
 		})
 	}
 }
+
+func TestFindFiles(t *testing.T) {
+	dir := fs.NewDir(t, "mytestfiles",
+		fs.WithFile("foo.spec.js", "foo", fs.WithMode(0755)),
+		fs.WithFile("README.md", "readme", fs.WithMode(0755)),
+		fs.WithDir("mysubdir",
+			fs.WithFile("bar.spec.js", "bar", fs.WithMode(0755)),
+			fs.WithFile("baz.spec.ts", "baz", fs.WithMode(0755)),
+		),
+	)
+	defer dir.Remove()
+
+	tests := []struct {
+		name     string
+		patterns []string
+		want     []string
+	}{
+		{
+			name:     "match everything",
+			patterns: []string{"**/*.*"},
+			want:     []string{"README.md", "foo.spec.js", "mysubdir/bar.spec.js", "mysubdir/baz.spec.ts"},
+		},
+		{
+			name:     "single star does not cross directories",
+			patterns: []string{"*.spec.js"},
+			want:     []string{"foo.spec.js"},
+		},
+		{
+			name:     "alternatives",
+			patterns: []string{"**/*.spec.{js,ts}"},
+			want:     []string{"foo.spec.js", "mysubdir/bar.spec.js", "mysubdir/baz.spec.ts"},
+		},
+		{
+			name:     "multiple patterns",
+			patterns: []string{"mysubdir/bar.spec.js", "README.md"},
+			want:     []string{"README.md", "mysubdir/bar.spec.js"},
+		},
+		{
+			name:     "directory wildcard",
+			patterns: []string{"*/*.spec.ts"},
+			want:     []string{"mysubdir/baz.spec.ts"},
+		},
+		{
+			name:     "nested alternatives",
+			patterns: []string{"{foo,mysubdir/{bar,qux}}.spec.js"},
+			want:     []string{"foo.spec.js", "mysubdir/bar.spec.js"},
+		},
+		{
+			name:     "no match",
+			patterns: []string{"**/*.java"},
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindFiles(dir.Path(), tt.patterns)
+			if err != nil {
+				t.Errorf("FindFiles() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindFiles() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindFiles_InvalidPattern(t *testing.T) {
+	dir := fs.NewDir(t, "mytestfiles", fs.WithFile("foo.spec.js", "foo", fs.WithMode(0755)))
+	defer dir.Remove()
+
+	if _, err := FindFiles(dir.Path(), []string{"**/[a-.spec.js"}); err == nil {
+		t.Error("FindFiles() expected an error for a malformed pattern")
+	}
+}
@@ -20,6 +20,8 @@ var DoneStates = []string{StateComplete, StateError, StatePassed, StateFailed}
 // execution instance (e.g. VM).
 type Job struct {
 	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Build      string `json:"build"`
 	Passed     bool   `json:"passed"`
 	Status     string `json:"status"`
 	Error      string `json:"error"`
@@ -31,6 +33,8 @@ type Job struct {
 
 	// IsRDC flags a job started as a RDC run.
 	IsRDC bool `json:"-"`
+	// TimedOut flags a job that was stopped because it exceeded the timeout of its suite.
+	TimedOut bool `json:"-"`
 }
 
 // Done returns true if the job status is one of DoneStates. False otherwise.
@@ -0,0 +1,9 @@
+package job
+
+import "context"
+
+// Lister is the interface for listing jobs.
+type Lister interface {
+	// ListJobs returns the most recent jobs, up to limit.
+	ListJobs(ctx context.Context, limit int) ([]Job, error)
+}
@@ -2,6 +2,7 @@ package job
 
 import (
 	"context"
+	"time"
 )
 
 // TestOptions represents the espresso test filter options configuration.
@@ -54,6 +55,12 @@ type StartOptions struct {
 	Experiments       map[string]string `json:"experiments,omitempty"`
 	TestOptions       TestOptions       `json:"testOptions,omitempty"`
 	TestsToRun        []string          `json:"testsToRun,omitempty"`
+
+	// Retries is the number of times a failed job is re-run before it is reported as failed.
+	Retries int `json:"-"`
+	// Timeout is how long the job may take, once started, before it is stopped and reported as timed out. No timeout
+	// if 0.
+	Timeout time.Duration `json:"-"`
 }
 
 // TunnelOptions represents the options that configure the usage of a tunnel when running tests in the Sauce Labs cloud.
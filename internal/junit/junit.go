@@ -2,6 +2,15 @@ package junit
 
 import "encoding/xml"
 
+// FileName is the name of the junit report that is attached to a job as an asset.
+const FileName = "junit.xml"
+
+// Property maps to a <property> element that's part of <properties>.
+type Property struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
 // TestCase maps to <testcase> element
 type TestCase struct {
 	Name       string `xml:"name,attr"`
@@ -9,24 +18,26 @@ type TestCase struct {
 	Time       string `xml:"time,attr"`
 	ClassName  string `xml:"classname,attr"`
 	Status     string `xml:"status,attr"`
-	SystemOut  string `xml:"system-out"`
-	Error      string `xml:"error"`
-	Failure    string `xml:"failure"`
+	SystemOut  string `xml:"system-out,omitempty"`
+	Error      string `xml:"error,omitempty"`
+	Failure    string `xml:"failure,omitempty"`
 }
 
 // TestSuite maps to <testsuite> element
 type TestSuite struct {
-	Name      string     `xml:"name,attr"`
-	Tests     int        `xml:"tests,attr"`
-	Errors    int        `xml:"errors,attr,omitempty"`
-	Failures  int        `xml:"failures,attr,omitempty"`
-	Disabled  int        `xml:"disabled,attr,omitempty"`
-	Skipped   int        `xml:"skipped,attr,omitempty"`
-	Time      string     `xml:"time,attr,omitempty"`
-	Timestamp string     `xml:"timestamp,attr,omitempty"`
-	Package   string     `xml:"package,attr,omitempty"`
-	TestCase  []TestCase `xml:"testcase"`
-	SystemOut string     `xml:"system-out"`
+	Name       string     `xml:"name,attr"`
+	Tests      int        `xml:"tests,attr"`
+	Errors     int        `xml:"errors,attr,omitempty"`
+	Failures   int        `xml:"failures,attr,omitempty"`
+	Disabled   int        `xml:"disabled,attr,omitempty"`
+	Skipped    int        `xml:"skipped,attr,omitempty"`
+	Time       string     `xml:"time,attr,omitempty"`
+	Timestamp  string     `xml:"timestamp,attr,omitempty"`
+	Package    string     `xml:"package,attr,omitempty"`
+	File       string     `xml:"file,attr,omitempty"`
+	Properties []Property `xml:"properties>property,omitempty"`
+	TestCase   []TestCase `xml:"testcase"`
+	SystemOut  string     `xml:"system-out,omitempty"`
 }
 
 // TestSuites maps to root junit <testsuites> element
@@ -0,0 +1,93 @@
+package matrix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Combination represents a single entry of a browser and platform matrix.
+type Combination struct {
+	// Name is the unique name of the suite that runs this combination.
+	Name     string
+	Browser  string
+	Platform string
+}
+
+// Expand returns every combination of browsers and platforms for the suite with the given name. An empty list of
+// browsers or platforms is substituted with the suite's single browser or platform, respectively. Duplicates are
+// ignored. The name of each combination only mentions the dimensions that were actually expanded, e.g.
+// "suite - chrome - Windows 10" if both lists were given, but "suite - chrome" if only browsers were given.
+// Returns nil if neither list was given, i.e. there is nothing to expand.
+func Expand(suite string, browser string, browsers []string, platform string, platforms []string) []Combination {
+	if len(browsers) == 0 && len(platforms) == 0 {
+		return nil
+	}
+
+	bb := unique(browsers)
+	if len(bb) == 0 {
+		bb = []string{browser}
+	}
+	pp := unique(platforms)
+	if len(pp) == 0 {
+		pp = []string{platform}
+	}
+
+	var cc []Combination
+	for _, b := range bb {
+		for _, p := range pp {
+			var parts []string
+			if len(browsers) > 0 {
+				parts = append(parts, b)
+			}
+			if len(platforms) > 0 {
+				parts = append(parts, p)
+			}
+			cc = append(cc, Combination{
+				Name:     fmt.Sprintf("%s - %s", suite, strings.Join(parts, " - ")),
+				Browser:  b,
+				Platform: p,
+			})
+		}
+	}
+	return cc
+}
+
+func unique(ss []string) []string {
+	var uu []string
+	seen := map[string]bool{}
+	for _, s := range ss {
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		uu = append(uu, s)
+	}
+	return uu
+}
+
+// Suite holds the settings of a suite that are expanded into combinations.
+type Suite struct {
+	Name      string
+	Browser   string
+	Browsers  []string
+	Platform  string
+	Platforms []string
+}
+
+// Suites expands the n suites of a project, whose framework specific types are adapted by suite and add.
+// suite returns the settings of the i-th suite. add is called for every suite of the expanded project, in order, with
+// the index of the suite that it originates from. c is nil for suites that have nothing to expand, and the
+// combination that replaces the browser and platform of the suite otherwise.
+func Suites(n int, suite func(i int) Suite, add func(i int, c *Combination)) {
+	for i := 0; i < n; i++ {
+		s := suite(i)
+		cc := Expand(s.Name, s.Browser, s.Browsers, s.Platform, s.Platforms)
+		if cc == nil {
+			add(i, nil)
+			continue
+		}
+		for j := range cc {
+			add(i, &cc[j])
+		}
+	}
+}
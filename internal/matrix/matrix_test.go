@@ -0,0 +1,77 @@
+package matrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		name      string
+		browsers  []string
+		platforms []string
+		want      []Combination
+	}{
+		{
+			name: "nothing to expand",
+		},
+		{
+			name:     "browsers only",
+			browsers: []string{"chrome", "firefox", "chrome"},
+			want: []Combination{
+				{Name: "e2e - chrome", Browser: "chrome", Platform: "Windows 10"},
+				{Name: "e2e - firefox", Browser: "firefox", Platform: "Windows 10"},
+			},
+		},
+		{
+			name:      "platforms only",
+			platforms: []string{"Windows 10", "macOS 11.00"},
+			want: []Combination{
+				{Name: "e2e - Windows 10", Browser: "edge", Platform: "Windows 10"},
+				{Name: "e2e - macOS 11.00", Browser: "edge", Platform: "macOS 11.00"},
+			},
+		},
+		{
+			name:      "browsers and platforms",
+			browsers:  []string{"chrome", "firefox"},
+			platforms: []string{"Windows 10", "macOS 11.00"},
+			want: []Combination{
+				{Name: "e2e - chrome - Windows 10", Browser: "chrome", Platform: "Windows 10"},
+				{Name: "e2e - chrome - macOS 11.00", Browser: "chrome", Platform: "macOS 11.00"},
+				{Name: "e2e - firefox - Windows 10", Browser: "firefox", Platform: "Windows 10"},
+				{Name: "e2e - firefox - macOS 11.00", Browser: "firefox", Platform: "macOS 11.00"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Expand("e2e", "edge", tt.browsers, "Windows 10", tt.platforms)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSuites(t *testing.T) {
+	ss := []Suite{
+		{Name: "single", Browser: "chrome"},
+		{Name: "matrix", Browser: "chrome", Browsers: []string{"chrome", "firefox"}, Platform: "Windows 10"},
+	}
+
+	type added struct {
+		i int
+		c *Combination
+	}
+	var got []added
+	Suites(len(ss), func(i int) Suite {
+		return ss[i]
+	}, func(i int, c *Combination) {
+		got = append(got, added{i: i, c: c})
+	})
+
+	assert.Equal(t, []added{
+		{i: 0},
+		{i: 1, c: &Combination{Name: "matrix - chrome", Browser: "chrome", Platform: "Windows 10"}},
+		{i: 1, c: &Combination{Name: "matrix - firefox", Browser: "firefox", Platform: "Windows 10"}},
+	}, got)
+}
@@ -1,11 +1,13 @@
 package mocks
 
+import "context"
+
 // FakeArifactDownloader defines a fake Downloader
 type FakeArifactDownloader struct {
-	DownloadArtifactFn func(jobID string)
+	DownloadArtifactFn func(ctx context.Context, jobID string) error
 }
 
 // DownloadArtifact defines a fake function for FakeDownloader
-func (f *FakeArifactDownloader) DownloadArtifact(jobID string) {
-	f.DownloadArtifactFn(jobID)
+func (f *FakeArifactDownloader) DownloadArtifact(ctx context.Context, jobID string) error {
+	return f.DownloadArtifactFn(ctx, jobID)
 }
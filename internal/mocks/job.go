@@ -44,6 +44,17 @@ func (fjr *FakeJobReader) GetJobAssetFileNames(ctx context.Context, jobID string
 	return fjr.GetJobAssetFileNamesFn(ctx, jobID)
 }
 
+// FakeRDCJobReader rdc mock
+type FakeRDCJobReader struct {
+	FakeJobReader
+	PollDevicesStateFn func(ctx context.Context, id string, interval time.Duration) (string, error)
+}
+
+// PollDevicesState mock function
+func (fjr *FakeRDCJobReader) PollDevicesState(ctx context.Context, id string, interval time.Duration) (string, error) {
+	return fjr.PollDevicesStateFn(ctx, id, interval)
+}
+
 // FakeJobStopper resto mock
 type FakeJobStopper struct {
 	StopJobFn func(ctx context.Context, jobID string) (job.Job, error)
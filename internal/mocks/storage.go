@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"errors"
 	"github.com/saucelabs/saucectl/internal/storage"
 )
@@ -11,7 +12,7 @@ type FakeProjectUploader struct {
 }
 
 // Upload mock function
-func (fpu *FakeProjectUploader) Upload(name string) (storage.ArtifactMeta, error) {
+func (fpu *FakeProjectUploader) Upload(ctx context.Context, name string) (storage.ArtifactMeta, error) {
 	if fpu.UploadSuccess {
 		return storage.ArtifactMeta{
 			ID: "fake-id",
@@ -21,6 +22,6 @@ func (fpu *FakeProjectUploader) Upload(name string) (storage.ArtifactMeta, error
 }
 
 // Find mock function
-func (fpu *FakeProjectUploader) Find(hash string) (storage.ArtifactMeta, error) {
+func (fpu *FakeProjectUploader) Find(ctx context.Context, hash string) (storage.ArtifactMeta, error) {
 	return storage.ArtifactMeta{}, nil
 }
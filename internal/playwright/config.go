@@ -1,18 +1,24 @@
 package playwright
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/saucelabs/saucectl/internal/config"
-	"gopkg.in/yaml.v2"
+	"github.com/saucelabs/saucectl/internal/matrix"
+	"github.com/saucelabs/saucectl/internal/shard"
 )
 
 var supportedBrwsList = []string{"chromium", "firefox", "webkit"}
 
+// defaultTestMatch mirrors the files that playwright considers to be tests by default.
+const defaultTestMatch = "**/*.{spec,test}.{js,ts,mjs}"
+
 // Project represents the playwright project configuration.
 type Project struct {
 	config.TypeDef `yaml:",inline"`
@@ -27,6 +33,7 @@ type Project struct {
 	RootDir        string             `yaml:"rootDir,omitempty" json:"rootDir"`
 	RunnerVersion  string             `yaml:"runnerVersion,omitempty" json:"runnerVersion"`
 	Artifacts      config.Artifacts   `yaml:"artifacts,omitempty" json:"artifacts"`
+	Reporters      config.Reporters   `yaml:"reporters,omitempty" json:"-"`
 	Defaults       config.Defaults    `yaml:"defaults,omitempty" json:"defaults"`
 }
 
@@ -40,13 +47,18 @@ type Playwright struct {
 // Suite represents the playwright test suite configuration.
 type Suite struct {
 	Name              string            `yaml:"name,omitempty" json:"name"`
-	Mode              string            `yaml:"mode,omitempty" json:"-"`
+	Mode              string            `yaml:"mode,omitempty" json:"-" enum:"docker,sauce"`
 	PlaywrightVersion string            `yaml:"playwrightVersion,omitempty" json:"playwrightVersion,omitempty"`
 	TestMatch         string            `yaml:"testMatch,omitempty" json:"testMatch,omitempty"`
 	PlatformName      string            `yaml:"platformName,omitempty" json:"platformName,omitempty"`
 	Params            SuiteConfig       `yaml:"params,omitempty" json:"param,omitempty"`
 	ScreenResolution  string            `yaml:"screenResolution,omitempty" json:"screenResolution,omitempty"`
 	Env               map[string]string `yaml:"env,omitempty" json:"env,omitempty"`
+	Retries           *int              `yaml:"retries,omitempty" json:"-"`
+	Shard             string            `yaml:"shard,omitempty" json:"-" enum:"spec,concurrency"`
+	Browsers          []string          `yaml:"browsers,omitempty" json:"-"`
+	Platforms         []string          `yaml:"platforms,omitempty" json:"-"`
+	Timeout           time.Duration     `yaml:"timeout,omitempty" json:"-"`
 }
 
 // SuiteConfig represents the configuration specific to a suite
@@ -62,15 +74,15 @@ type SuiteConfig struct {
 func FromFile(cfgPath string) (Project, error) {
 	var p Project
 
-	f, err := os.Open(cfgPath)
+	b, err := config.Resolve(cfgPath)
 	if err != nil {
 		return Project{}, fmt.Errorf("failed to locate project config: %v", err)
 	}
-	defer f.Close()
 
-	if err := yaml.NewDecoder(f).Decode(&p); err != nil {
+	if err := config.Decode(bytes.NewReader(b), &p); err != nil {
 		return Project{}, fmt.Errorf("failed to parse project config: %v", err)
 	}
+	ExpandSuites(&p)
 
 	if err := checkSupportedBrowsers(&p); err != nil {
 		return Project{}, err
@@ -128,6 +140,26 @@ func FromFile(cfgPath string) (Project, error) {
 	return p, nil
 }
 
+// ExpandSuites replaces each suite that lists multiple browsers or platforms with a suite per combination of them.
+func ExpandSuites(p *Project) {
+	var suites []Suite
+	matrix.Suites(len(p.Suites), func(i int) matrix.Suite {
+		s := p.Suites[i]
+		return matrix.Suite{Name: s.Name, Browser: s.Params.BrowserName, Browsers: s.Browsers, Platform: s.PlatformName, Platforms: s.Platforms}
+	}, func(i int, c *matrix.Combination) {
+		s := p.Suites[i]
+		if c != nil {
+			s.Name = c.Name
+			s.Params.BrowserName = c.Browser
+			s.PlatformName = c.Platform
+			s.Browsers = nil
+			s.Platforms = nil
+		}
+		suites = append(suites, s)
+	})
+	p.Suites = suites
+}
+
 // SplitSuites divided Suites to dockerSuites and sauceSuites
 func SplitSuites(p Project) (Project, Project) {
 	var dockerSuites []Suite
@@ -169,3 +201,39 @@ func isSupportedBrowser(browser string) bool {
 
 	return false
 }
+
+// ShardSuites replaces each suite that has sharding enabled with replicas of itself, each of which runs a subset of
+// the test files that match testMatch of the original suite.
+func ShardSuites(p *Project, c *shard.Cache) error {
+	var suites []Suite
+	err := shard.Suites(len(p.Suites), p.Sauce.Concurrency, c, func(i int) (shard.Suite, error) {
+		s := p.Suites[i]
+		testMatch := s.TestMatch
+		if testMatch == "" {
+			testMatch = defaultTestMatch
+		}
+		return shard.Suite{Name: s.Name, Shard: s.Shard, Dir: p.RootDir, Patterns: []string{testMatch},
+			PatternsKey: "testMatch"}, nil
+	}, func(i int, r *shard.Replica) {
+		s := p.Suites[i]
+		if r != nil {
+			s.Name = r.Name
+			s.TestMatch = joinPatterns(r.Files)
+		}
+		suites = append(suites, s)
+	})
+	if err != nil {
+		return err
+	}
+	p.Suites = suites
+
+	return nil
+}
+
+// joinPatterns combines several glob patterns into one.
+func joinPatterns(patterns []string) string {
+	if len(patterns) == 1 {
+		return patterns[0]
+	}
+	return fmt.Sprintf("{%s}", strings.Join(patterns, ","))
+}
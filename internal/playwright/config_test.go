@@ -0,0 +1,81 @@
+package playwright
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/shard"
+	"github.com/stretchr/testify/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestShardSuites(t *testing.T) {
+	dir := fs.NewDir(t, "playwright-project",
+		fs.WithFile("a.spec.js", ""),
+		fs.WithFile("b.spec.js", ""),
+		fs.WithFile("c.spec.js", ""),
+		fs.WithFile("helper.js", ""),
+	)
+	defer dir.Remove()
+
+	testCases := []struct {
+		name          string
+		suite         Suite
+		wantNames     []string
+		wantTestMatch []string
+	}{
+		{
+			name:          "no sharding",
+			suite:         Suite{Name: "suite", TestMatch: "*.js"},
+			wantNames:     []string{"suite"},
+			wantTestMatch: []string{"*.js"},
+		},
+		{
+			name:          "default testMatch",
+			suite:         Suite{Name: "suite", Shard: shard.Spec},
+			wantNames:     []string{"suite - a.spec.js", "suite - b.spec.js", "suite - c.spec.js"},
+			wantTestMatch: []string{"a.spec.js", "b.spec.js", "c.spec.js"},
+		},
+		{
+			name:          "joins the files of a replica",
+			suite:         Suite{Name: "suite", Shard: shard.Concurrency, TestMatch: "*.spec.js"},
+			wantNames:     []string{"suite - 1/2", "suite - 2/2"},
+			wantTestMatch: []string{"{a.spec.js,c.spec.js}", "b.spec.js"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := Project{
+				RootDir: dir.Path(),
+				Sauce:   config.SauceConfig{Concurrency: 2},
+				Suites:  []Suite{tc.suite},
+			}
+			err := ShardSuites(&p, shard.NewCache(filepath.Join(t.TempDir(), "durations.json")))
+			assert.NoError(t, err)
+
+			var names, testMatch []string
+			for _, s := range p.Suites {
+				names = append(names, s.Name)
+				testMatch = append(testMatch, s.TestMatch)
+			}
+			assert.Equal(t, tc.wantNames, names)
+			assert.Equal(t, tc.wantTestMatch, testMatch)
+		})
+	}
+}
+
+func TestExpandSuites(t *testing.T) {
+	p := Project{Suites: []Suite{
+		{Name: "single", Params: SuiteConfig{BrowserName: "chromium"}},
+		{Name: "matrix", Params: SuiteConfig{BrowserName: "chromium", Video: true}, Platforms: []string{"Windows 10", "macOS 11.00"}},
+	}}
+
+	ExpandSuites(&p)
+
+	assert.Equal(t, []Suite{
+		{Name: "single", Params: SuiteConfig{BrowserName: "chromium"}},
+		{Name: "matrix - Windows 10", Params: SuiteConfig{BrowserName: "chromium", Video: true}, PlatformName: "Windows 10"},
+		{Name: "matrix - macOS 11.00", Params: SuiteConfig{BrowserName: "chromium", Video: true}, PlatformName: "macOS 11.00"},
+	}, p.Suites)
+}
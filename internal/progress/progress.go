@@ -23,3 +23,11 @@ func Show(text string, args ...interface{}) *spinner.Spinner {
 func Stop() {
 	spinnerInstance.Stop()
 }
+
+// Update changes the text of the progress spinner, without restarting it.
+func Update(text string, args ...interface{}) {
+	message := " " + fmt.Sprintf(text, args...)
+	spinnerInstance.Lock()
+	spinnerInstance.Suffix = message
+	spinnerInstance.Unlock()
+}
@@ -1,13 +1,15 @@
 package puppeteer
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/saucelabs/saucectl/internal/config"
-	"gopkg.in/yaml.v2"
+	"github.com/saucelabs/saucectl/internal/shard"
 )
 
 // Project represents the puppeteer project configuration.
@@ -23,6 +25,7 @@ type Project struct {
 	Npm            config.Npm         `yaml:"npm,omitempty" json:"npm"`
 	RootDir        string             `yaml:"rootDir,omitempty" json:"rootDir"`
 	Artifacts      config.Artifacts   `yaml:"artifacts,omitempty" json:"artifacts"`
+	Reporters      config.Reporters   `yaml:"reporters,omitempty" json:"-"`
 }
 
 // Suite represents the puppeteer test suite configuration.
@@ -31,6 +34,9 @@ type Suite struct {
 	Browser   string            `yaml:"browser,omitempty" json:"browser"`
 	TestMatch []string          `yaml:"testMatch,omitempty" json:"testMatch"`
 	Env       map[string]string `yaml:"env,omitempty" json:"env"`
+	Retries   *int              `yaml:"retries,omitempty" json:"-"`
+	Shard     string            `yaml:"shard,omitempty" json:"-" enum:"spec,concurrency"`
+	Timeout   time.Duration     `yaml:"timeout,omitempty" json:"-"`
 }
 
 // Puppeteer represents the configuration for puppeteer.
@@ -43,13 +49,12 @@ type Puppeteer struct {
 func FromFile(cfgPath string) (Project, error) {
 	var p Project
 
-	f, err := os.Open(cfgPath)
+	b, err := config.Resolve(cfgPath)
 	if err != nil {
 		return p, fmt.Errorf("failed to locate project config: %v", err)
 	}
-	defer f.Close()
 
-	if err := yaml.NewDecoder(f).Decode(&p); err != nil {
+	if err := config.Decode(bytes.NewReader(b), &p); err != nil {
 		return Project{}, fmt.Errorf("failed to parse project config: %v", err)
 	}
 	p.ConfigFilePath = cfgPath
@@ -88,3 +93,27 @@ func FromFile(cfgPath string) (Project, error) {
 	}
 	return p, nil
 }
+
+// ShardSuites replaces each suite that has sharding enabled with replicas of itself, each of which runs a subset of
+// the test files that match testMatch of the original suite.
+func ShardSuites(p *Project, c *shard.Cache) error {
+	var suites []Suite
+	err := shard.Suites(len(p.Suites), p.Sauce.Concurrency, c, func(i int) (shard.Suite, error) {
+		s := p.Suites[i]
+		return shard.Suite{Name: s.Name, Shard: s.Shard, Dir: p.RootDir, Patterns: s.TestMatch,
+			PatternsKey: "testMatch"}, nil
+	}, func(i int, r *shard.Replica) {
+		s := p.Suites[i]
+		if r != nil {
+			s.Name = r.Name
+			s.TestMatch = r.Files
+		}
+		suites = append(suites, s)
+	})
+	if err != nil {
+		return err
+	}
+	p.Suites = suites
+
+	return nil
+}
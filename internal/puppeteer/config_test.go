@@ -0,0 +1,37 @@
+package puppeteer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/shard"
+	"github.com/stretchr/testify/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestShardSuites(t *testing.T) {
+	dir := fs.NewDir(t, "puppeteer-project",
+		fs.WithDir("tests",
+			fs.WithFile("a.test.js", ""),
+			fs.WithFile("b.test.js", ""),
+		),
+	)
+	defer dir.Remove()
+
+	p := Project{
+		RootDir: dir.Path(),
+		Sauce:   config.SauceConfig{Concurrency: 2},
+		Suites: []Suite{
+			{Name: "plain", TestMatch: []string{"tests/*.js"}},
+			{Name: "sharded", Shard: shard.Spec, TestMatch: []string{"tests/*.js"}},
+		},
+	}
+	err := ShardSuites(&p, shard.NewCache(filepath.Join(t.TempDir(), "durations.json")))
+	assert.NoError(t, err)
+	assert.Equal(t, []Suite{
+		{Name: "plain", TestMatch: []string{"tests/*.js"}},
+		{Name: "sharded - tests/a.test.js", Shard: shard.Spec, TestMatch: []string{"tests/a.test.js"}},
+		{Name: "sharded - tests/b.test.js", Shard: shard.Spec, TestMatch: []string{"tests/b.test.js"}},
+	}, p.Suites)
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -47,15 +48,41 @@ type concurrencyResponse struct {
 }
 
 type readJobResponse struct {
+	ID                 string `json:"id,omitempty"`
+	Name               string `json:"name,omitempty"`
+	Build              string `json:"build,omitempty"`
 	Status             string `json:"status,omitempty"`
 	ConsolidatedStatus string `json:"consolidated_status,omitempty"`
 	Error              string `json:"error,omitempty"`
+	DeviceName         string `json:"device_name,omitempty"`
+	OS                 string `json:"os,omitempty"`
+	OSVersion          string `json:"os_version,omitempty"`
+}
+
+type listJobsResponse struct {
+	Entities []readJobResponse `json:"entities"`
+}
+
+// toJob converts the response into a job.Job.
+func (r readJobResponse) toJob(id string) job.Job {
+	j := job.Job{
+		ID:     id,
+		Name:   r.Name,
+		Build:  r.Build,
+		Error:  r.Error,
+		Status: r.Status,
+		Passed: r.Status == job.StatePassed,
+	}
+	j.BaseConfig.DeviceName = r.DeviceName
+	j.BaseConfig.PlatformName = r.OS
+	j.BaseConfig.PlatformVersion = r.OSVersion
+	return j
 }
 
 // New creates a new client.
 func New(url, username, accessKey string, timeout time.Duration, artifactConfig config.ArtifactDownload) Client {
 	return Client{
-		HTTPClient:     &http.Client{Timeout: timeout},
+		HTTPClient:     requesth.NewClient(timeout),
 		URL:            url,
 		Username:       username,
 		AccessKey:      accessKey,
@@ -112,12 +139,69 @@ func (c *Client) ReadJob(ctx context.Context, id string) (job.Job, error) {
 	if err := json.NewDecoder(resp.Body).Decode(&jr); err != nil {
 		return job.Job{}, err
 	}
-	return job.Job{
-		ID:     id,
-		Error:  jr.Error,
-		Status: jr.Status,
-		Passed: jr.Status == job.StatePassed,
-	}, nil
+	return jr.toJob(id), nil
+}
+
+// ListJobs returns the most recent jobs, up to limit.
+func (c *Client) ListJobs(ctx context.Context, limit int) ([]job.Job, error) {
+	req, err := requesth.NewWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/v1/rdc/jobs", c.URL), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.AccessKey)
+
+	q := req.URL.Query()
+	q.Add("limit", strconv.Itoa(limit))
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("unexpected statusCode: %v", resp.StatusCode)
+	}
+
+	var lr listJobsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&lr); err != nil {
+		return nil, err
+	}
+
+	var jobs []job.Job
+	for _, e := range lr.Entities {
+		j := e.toJob(e.ID)
+		j.IsRDC = true
+		jobs = append(jobs, j)
+	}
+	return jobs, nil
+}
+
+// StopJob stops the job on the Sauce Cloud.
+func (c *Client) StopJob(ctx context.Context, id string) (job.Job, error) {
+	req, err := requesth.NewWithContext(ctx, http.MethodPut,
+		fmt.Sprintf("%s/v1/rdc/jobs/%s/stop", c.URL, id), nil)
+	if err != nil {
+		return job.Job{}, err
+	}
+	req.SetBasicAuth(c.Username, c.AccessKey)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return job.Job{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return job.Job{}, ErrJobNotFound
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return job.Job{}, fmt.Errorf("unexpected statusCode: %v", resp.StatusCode)
+	}
+
+	return c.ReadJob(ctx, id)
 }
 
 // PollJob polls job details at an interval, until the job has ended, whether successfully or due to an error.
@@ -132,7 +216,13 @@ func (c *Client) PollJob(ctx context.Context, id string, interval time.Duration)
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
-	for range ticker.C {
+	for {
+		select {
+		case <-ctx.Done():
+			return job.Job{}, ctx.Err()
+		case <-ticker.C:
+		}
+
 		j, err := doRequestStatus(c.HTTPClient, req)
 		if err != nil {
 			return job.Job{}, err
@@ -143,8 +233,6 @@ func (c *Client) PollJob(ctx context.Context, id string, interval time.Duration)
 			return j, nil
 		}
 	}
-
-	return job.Job{}, nil
 }
 
 func doRequestStatus(httpClient *http.Client, request *http.Request) (job.Job, error) {
@@ -189,7 +277,13 @@ func (c *Client) PollDevicesState(ctx context.Context, ids string, interval time
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
-	for range ticker.C {
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+
 		j, err := doDeviceStatus(c.HTTPClient, req)
 		if err != nil {
 			return "nil", err
@@ -204,8 +298,6 @@ func (c *Client) PollDevicesState(ctx context.Context, ids string, interval time
 			}
 		}
 	}
-
-	return "", nil
 }
 
 func doDeviceStatus(httpClient *http.Client, request *http.Request) ([]string, error) {
@@ -333,33 +425,38 @@ func convertDeviceLogs(data []byte) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
-// DownloadArtifact does downloading artifacts
-func (c *Client) DownloadArtifact(jobID string) {
-	targetDir := filepath.Join(c.ArtifactConfig.Directory, jobID)
-	if err := os.MkdirAll(targetDir, 0755); err != nil {
-		log.Error().Msgf("Unable to create %s to fetch artifacts (%v)", targetDir, err)
-		return
-	}
-
-	files, err := c.GetJobAssetFileNames(context.Background(), jobID)
+// DownloadArtifact downloads the artifacts of the job that match the ArtifactConfig into a folder named after the job.
+// The folder is only created if there is anything to download.
+func (c *Client) DownloadArtifact(ctx context.Context, jobID string) error {
+	files, err := c.GetJobAssetFileNames(ctx, jobID)
 	if err != nil {
-		log.Error().Msgf("Unable to fetch artifacts list (%v)", err)
-		return
+		return fmt.Errorf("unable to fetch artifacts list: %w", err)
 	}
+
+	targetDir := filepath.Join(c.ArtifactConfig.Directory, jobID)
+	failed := 0
 	for _, f := range files {
 		for _, pattern := range c.ArtifactConfig.Match {
 			if glob.Glob(pattern, f) {
-				if err := c.downloadArtifact(targetDir, jobID, f); err != nil {
+				if err := os.MkdirAll(targetDir, 0755); err != nil {
+					return fmt.Errorf("unable to create %s to fetch artifacts: %w", targetDir, err)
+				}
+				if err := c.downloadArtifact(ctx, targetDir, jobID, f); err != nil {
 					log.Error().Err(err).Msgf("Failed to download file: %s", f)
+					failed++
 				}
 				break
 			}
 		}
 	}
+	if failed > 0 {
+		return fmt.Errorf("failed to download %d artifact(s) of job %s", failed, jobID)
+	}
+	return nil
 }
 
-func (c *Client) downloadArtifact(targetDir, jobID, fileName string) error {
-	content, err := c.GetJobAssetFileContent(context.Background(), jobID, fileName)
+func (c *Client) downloadArtifact(ctx context.Context, targetDir, jobID, fileName string) error {
+	content, err := c.GetJobAssetFileContent(ctx, jobID, fileName)
 	if err != nil {
 		return err
 	}
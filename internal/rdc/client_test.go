@@ -15,9 +15,15 @@ import (
 
 	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/job"
+	"github.com/saucelabs/saucectl/internal/requesth"
 	"github.com/stretchr/testify/assert"
 )
 
+func init() {
+	// Failed requests are retried, which tests should not have to wait for.
+	requesth.DefaultMinWait = 0
+}
+
 func TestClient_ReadAllowedCCY(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -333,7 +339,9 @@ func TestClient_DownloadArtifact(t *testing.T) {
 		Directory: tempDir,
 		Match: []string{"junit.xml"},
 	})
-	rc.DownloadArtifact("test-123")
+	if err := rc.DownloadArtifact(context.Background(), "test-123"); err != nil {
+		t.Errorf("DownloadArtifact() error = %v", err)
+	}
 
 	fileName := filepath.Join(tempDir, "test-123", "junit.xml")
 	d, err := os.ReadFile(fileName)
@@ -345,3 +353,48 @@ func TestClient_DownloadArtifact(t *testing.T) {
 		t.Errorf("file content mismatch: got '%v', expects: '%v'", d, fileContent)
 	}
 }
+
+func TestClient_ListJobs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/rdc/jobs" || r.URL.Query().Get("limit") != "1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"entities": [{"id": "abc", "name": "espresso", "status": "passed", "device_name": "Google Pixel 4", "os": "ANDROID", "os_version": "11"}]}`))
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL, "test", "123", 3*time.Second, config.ArtifactDownload{})
+	jobs, err := client.ListJobs(context.Background(), 1)
+	assert.NoError(t, err)
+
+	want := job.Job{ID: "abc", Name: "espresso", Status: "passed", Passed: true, IsRDC: true}
+	want.BaseConfig.DeviceName = "Google Pixel 4"
+	want.BaseConfig.PlatformName = "ANDROID"
+	want.BaseConfig.PlatformVersion = "11"
+	assert.Equal(t, []job.Job{want}, jobs)
+}
+
+func TestClient_StopJob(t *testing.T) {
+	stopped := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/rdc/jobs/abc/stop":
+			stopped = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/rdc/jobs/abc" && stopped:
+			w.Write([]byte(`{"status": "failed", "error": "User Abandoned Test"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL, "test", "123", 3*time.Second, config.ArtifactDownload{})
+	j, err := client.StopJob(context.Background(), "abc")
+	assert.NoError(t, err)
+	assert.Equal(t, job.Job{ID: "abc", Status: "failed", Error: "User Abandoned Test"}, j)
+
+	_, err = client.StopJob(context.Background(), "unknown")
+	assert.Equal(t, ErrJobNotFound, err)
+}
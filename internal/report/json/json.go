@@ -0,0 +1,133 @@
+package json
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/internal/report"
+)
+
+// Reporter is a json implementation for report.Reporter. It writes a machine-readable summary of the run to a file.
+type Reporter struct {
+	TestResults []report.TestResult
+	Filename    string
+	// ExitCode is the code that saucectl exits with. It's set once the run is over, since it also reflects failures
+	// that aren't tied to a suite.
+	ExitCode int
+	lock     sync.Mutex
+}
+
+// summary represents the content of the json report.
+type summary struct {
+	Passed   bool    `json:"passed"`
+	ExitCode int     `json:"exitCode"`
+	Totals   totals  `json:"totals"`
+	Suites   []suite `json:"suites"`
+}
+
+// totals represents the run-level counts of the json report.
+type totals struct {
+	Suites   int     `json:"suites"`
+	Passed   int     `json:"passed"`
+	Failed   int     `json:"failed"`
+	TimedOut int     `json:"timedOut"`
+	Skipped  int     `json:"skipped"`
+	Duration float64 `json:"duration"`
+}
+
+// suite represents a single test result in the json report.
+type suite struct {
+	Name       string   `json:"name"`
+	JobID      string   `json:"jobId,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	Duration   float64  `json:"duration"`
+	Passed     bool     `json:"passed"`
+	Skipped    bool     `json:"skipped"`
+	TimedOut   bool     `json:"timedOut,omitempty"`
+	Error      string   `json:"error,omitempty"`
+	Browser    string   `json:"browser,omitempty"`
+	Platform   string   `json:"platform,omitempty"`
+	DeviceName string   `json:"deviceName,omitempty"`
+	Attempts   int      `json:"attempts,omitempty"`
+	URLs       []string `json:"urls,omitempty"`
+}
+
+// Add adds the test result that can be rendered by Render.
+func (r *Reporter) Add(t report.TestResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.TestResults = append(r.TestResults, t)
+}
+
+// Render renders the test results as json and writes them to Reporter.Filename.
+func (r *Reporter) Render() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	s := summary{Passed: true, Suites: make([]suite, 0, len(r.TestResults))}
+	for _, v := range r.TestResults {
+		s.Totals.Suites++
+		s.Totals.Duration += v.Duration.Seconds()
+		switch {
+		case v.Skipped:
+			s.Totals.Skipped++
+		case v.TimedOut:
+			s.Totals.TimedOut++
+		case v.Passed:
+			s.Totals.Passed++
+		default:
+			s.Totals.Failed++
+		}
+
+		// Same as the runners: a run only passes if every suite has passed, skipped suites included.
+		if !v.Passed {
+			s.Passed = false
+		}
+
+		s.Suites = append(s.Suites, suite{
+			Name:       v.Name,
+			JobID:      v.JobID,
+			URL:        v.URL,
+			Duration:   v.Duration.Seconds(),
+			Passed:     v.Passed,
+			Skipped:    v.Skipped,
+			TimedOut:   v.TimedOut,
+			Error:      v.Error,
+			Browser:    v.Browser,
+			Platform:   v.Platform,
+			DeviceName: v.DeviceName,
+			Attempts:   v.Attempts,
+			URLs:       v.URLs,
+		})
+	}
+	s.ExitCode = r.ExitCode
+	if s.ExitCode != 0 {
+		s.Passed = false
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create json report.")
+		return
+	}
+
+	if err := os.WriteFile(r.Filename, b, 0644); err != nil {
+		log.Error().Err(err).Str("filename", r.Filename).Msg("Failed to write json report.")
+		return
+	}
+	log.Info().Str("filename", r.Filename).Msg("JSON report written.")
+}
+
+// Reset resets the reporter to its initial state. This action will delete all test results.
+func (r *Reporter) Reset() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.TestResults = make([]report.TestResult, 0)
+}
+
+// ArtifactRequirements returns a list of artifact types that are requested by this reporter.
+func (r *Reporter) ArtifactRequirements() []report.ArtifactType {
+	return nil
+}
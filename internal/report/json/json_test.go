@@ -0,0 +1,115 @@
+package json
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/saucelabs/saucectl/internal/report"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReporter_Render(t *testing.T) {
+	r := Reporter{Filename: filepath.Join(t.TempDir(), "report.json"), ExitCode: 1}
+	r.Add(report.TestResult{
+		Name:     "Chrome",
+		JobID:    "123",
+		URL:      "https://app.saucelabs.com/tests/123",
+		Duration: 1500 * time.Millisecond,
+		Passed:   true,
+		Browser:  "Chrome",
+		Platform: "Windows 10",
+	})
+	r.Add(report.TestResult{
+		Name:       "Pixel",
+		JobID:      "456",
+		URL:        "https://app.saucelabs.com/tests/456",
+		Duration:   2 * time.Second,
+		Passed:     false,
+		Error:      "suite 'Pixel' has test failures",
+		Platform:   "Android 11",
+		DeviceName: "Google Pixel",
+	})
+	r.Add(report.TestResult{
+		Name:    "Firefox",
+		Skipped: true,
+	})
+	r.Add(report.TestResult{
+		Name:     "Safari",
+		JobID:    "789",
+		URL:      "https://app.saucelabs.com/tests/789",
+		Duration: time.Second,
+		TimedOut: true,
+		Error:    "suite 'Safari' has timed out after 1s",
+	})
+	r.Render()
+
+	got, err := os.ReadFile(r.Filename)
+	assert.NoError(t, err)
+
+	want := `{
+  "passed": false,
+  "exitCode": 1,
+  "totals": {
+    "suites": 4,
+    "passed": 1,
+    "failed": 1,
+    "timedOut": 1,
+    "skipped": 1,
+    "duration": 4.5
+  },
+  "suites": [
+    {
+      "name": "Chrome",
+      "jobId": "123",
+      "url": "https://app.saucelabs.com/tests/123",
+      "duration": 1.5,
+      "passed": true,
+      "skipped": false,
+      "browser": "Chrome",
+      "platform": "Windows 10"
+    },
+    {
+      "name": "Pixel",
+      "jobId": "456",
+      "url": "https://app.saucelabs.com/tests/456",
+      "duration": 2,
+      "passed": false,
+      "skipped": false,
+      "error": "suite 'Pixel' has test failures",
+      "platform": "Android 11",
+      "deviceName": "Google Pixel"
+    },
+    {
+      "name": "Firefox",
+      "duration": 0,
+      "passed": false,
+      "skipped": true
+    },
+    {
+      "name": "Safari",
+      "jobId": "789",
+      "url": "https://app.saucelabs.com/tests/789",
+      "duration": 1,
+      "passed": false,
+      "skipped": false,
+      "timedOut": true,
+      "error": "suite 'Safari' has timed out after 1s"
+    }
+  ]
+}`
+	assert.Equal(t, want, string(got))
+}
+
+func TestReporter_Render_ExitCode(t *testing.T) {
+	// The project may fail to upload after some suites have passed, e.g. in docker.
+	r := Reporter{Filename: filepath.Join(t.TempDir(), "report.json"), ExitCode: 1}
+	r.Add(report.TestResult{Name: "Chrome", Passed: true})
+	r.Render()
+
+	got, err := os.ReadFile(r.Filename)
+	assert.NoError(t, err)
+	assert.Contains(t, string(got), `"passed": false,
+  "exitCode": 1,`)
+}
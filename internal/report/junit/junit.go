@@ -0,0 +1,133 @@
+package junit
+
+import (
+	"encoding/xml"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/internal/junit"
+	"github.com/saucelabs/saucectl/internal/report"
+)
+
+// Reporter is a junit implementation for report.Reporter. It merges the junit reports of all jobs into a single file.
+type Reporter struct {
+	TestResults []report.TestResult
+	Filename    string
+	lock        sync.Mutex
+}
+
+// Add adds the test result that can be rendered by Render.
+func (r *Reporter) Add(t report.TestResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.TestResults = append(r.TestResults, t)
+}
+
+// Render renders the merged junit report and writes it to Reporter.Filename.
+func (r *Reporter) Render() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	t := junit.TestSuites{}
+	var totalDur float64
+	for _, v := range r.TestResults {
+		// Skipped suites never ran and therefore have no test cases to report.
+		if v.Skipped {
+			continue
+		}
+		s := toTestSuite(v)
+		t.TestSuite = append(t.TestSuite, s)
+		t.Tests += s.Tests
+		t.Failures += s.Failures
+		t.Errors += s.Errors
+		t.Disabled += s.Disabled
+		totalDur += v.Duration.Seconds()
+	}
+	t.Time = formatSeconds(totalDur)
+
+	b, err := xml.MarshalIndent(t, "", "  ")
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create junit report.")
+		return
+	}
+
+	if err := os.WriteFile(r.Filename, append([]byte(xml.Header), b...), 0644); err != nil {
+		log.Error().Err(err).Str("filename", r.Filename).Msg("Failed to write junit report.")
+		return
+	}
+	log.Info().Str("filename", r.Filename).Msg("JUnit report written.")
+}
+
+// Reset resets the reporter to its initial state. This action will delete all test results.
+func (r *Reporter) Reset() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.TestResults = make([]report.TestResult, 0)
+}
+
+// ArtifactRequirements returns a list of artifact types that are requested by this reporter.
+func (r *Reporter) ArtifactRequirements() []report.ArtifactType {
+	return []report.ArtifactType{report.JUnitArtifact}
+}
+
+// toTestSuite converts the test result into a single <testsuite>, which contains the test cases of all the junit
+// reports that were attached to the test result.
+func toTestSuite(t report.TestResult) junit.TestSuite {
+	s := junit.TestSuite{
+		Name: t.Name,
+		Time: formatSeconds(t.Duration.Seconds()),
+	}
+
+	for _, p := range []junit.Property{
+		{Name: "browser", Value: t.Browser},
+		{Name: "platform", Value: t.Platform},
+		{Name: "device", Value: t.DeviceName},
+	} {
+		if p.Value != "" {
+			s.Properties = append(s.Properties, p)
+		}
+	}
+
+	for _, a := range t.Artifacts {
+		if a.AssetType != report.JUnitArtifact {
+			continue
+		}
+
+		tss, err := junit.Parse(a.Body)
+		if err != nil {
+			log.Warn().Err(err).Str("suite", t.Name).Msg("Failed to parse junit report.")
+			continue
+		}
+
+		for _, ts := range tss.TestSuite {
+			s.TestCase = append(s.TestCase, ts.TestCase...)
+			s.Tests += ts.Tests
+			s.Failures += ts.Failures
+			s.Errors += ts.Errors
+			s.Disabled += ts.Disabled
+			s.Skipped += ts.Skipped
+		}
+	}
+
+	// Without a junit report to go by, the suite itself is the only test case we know of.
+	if len(s.TestCase) == 0 {
+		tc := junit.TestCase{Name: t.Name, ClassName: t.Name, Time: s.Time}
+		s.Tests = 1
+		if t.TimedOut {
+			tc.Failure = "suite has timed out"
+			s.Failures = 1
+		} else if !t.Passed {
+			tc.Failure = "suite has failed"
+			s.Failures = 1
+		}
+		s.TestCase = []junit.TestCase{tc}
+	}
+
+	return s
+}
+
+func formatSeconds(s float64) string {
+	return strconv.FormatFloat(s, 'f', 3, 64)
+}
@@ -0,0 +1,146 @@
+package junit
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/saucelabs/saucectl/internal/junit"
+	"github.com/saucelabs/saucectl/internal/report"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReporter_Render(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []report.TestResult
+		want    junit.TestSuites
+	}{
+		{
+			name: "merges attached junit reports",
+			results: []report.TestResult{
+				{
+					Name:     "Firefox",
+					Duration: 34479 * time.Millisecond,
+					Passed:   false,
+					Browser:  "Firefox",
+					Platform: "Windows 10",
+					Artifacts: []report.Artifact{
+						{
+							AssetType: report.JUnitArtifact,
+							Body: []byte(`<testsuites>
+  <testsuite name="login" tests="2" failures="1">
+    <testcase name="works" classname="login"></testcase>
+    <testcase name="fails" classname="login"><failure>boom</failure></testcase>
+  </testsuite>
+</testsuites>`),
+						},
+					},
+				},
+			},
+			want: junit.TestSuites{
+				Tests:    2,
+				Failures: 1,
+				Time:     "34.479",
+				TestSuite: []junit.TestSuite{
+					{
+						Name:     "Firefox",
+						Tests:    2,
+						Failures: 1,
+						Time:     "34.479",
+						Properties: []junit.Property{
+							{Name: "browser", Value: "Firefox"},
+							{Name: "platform", Value: "Windows 10"},
+						},
+						TestCase: []junit.TestCase{
+							{Name: "works", ClassName: "login"},
+							{Name: "fails", ClassName: "login", Failure: "boom"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "synthesizes a test case without junit report",
+			results: []report.TestResult{
+				{
+					Name:       "Pixel",
+					Duration:   2 * time.Second,
+					Passed:     false,
+					Platform:   "Android 11",
+					DeviceName: "Google Pixel",
+				},
+			},
+			want: junit.TestSuites{
+				Tests:    1,
+				Failures: 1,
+				Time:     "2.000",
+				TestSuite: []junit.TestSuite{
+					{
+						Name:     "Pixel",
+						Tests:    1,
+						Failures: 1,
+						Time:     "2.000",
+						Properties: []junit.Property{
+							{Name: "platform", Value: "Android 11"},
+							{Name: "device", Value: "Google Pixel"},
+						},
+						TestCase: []junit.TestCase{
+							{Name: "Pixel", ClassName: "Pixel", Time: "2.000", Failure: "suite has failed"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reports timed out suite as failure",
+			results: []report.TestResult{
+				{
+					Name:     "Chrome",
+					Duration: 15 * time.Minute,
+					TimedOut: true,
+					Browser:  "Chrome",
+				},
+			},
+			want: junit.TestSuites{
+				Tests:    1,
+				Failures: 1,
+				Time:     "900.000",
+				TestSuite: []junit.TestSuite{
+					{
+						Name:     "Chrome",
+						Tests:    1,
+						Failures: 1,
+						Time:     "900.000",
+						Properties: []junit.Property{
+							{Name: "browser", Value: "Chrome"},
+						},
+						TestCase: []junit.TestCase{
+							{Name: "Chrome", ClassName: "Chrome", Time: "900.000", Failure: "suite has timed out"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reporter{Filename: filepath.Join(t.TempDir(), "report.xml")}
+			for _, res := range tt.results {
+				r.Add(res)
+			}
+			r.Render()
+
+			b, err := os.ReadFile(r.Filename)
+			assert.NoError(t, err)
+
+			got, err := junit.Parse(b)
+			assert.NoError(t, err)
+
+			got.XMLName = xml.Name{}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -5,11 +5,35 @@ import "time"
 // TestResult represents the test result.
 type TestResult struct {
 	Name       string
+	JobID      string
+	URL        string
 	Duration   time.Duration
 	Passed     bool
+	Skipped    bool
+	TimedOut   bool
+	Error      string
 	Browser    string
 	Platform   string
 	DeviceName string
+	// Attempts is the number of times the suite was run, retries included.
+	Attempts int
+	// URLs contains the job URLs of all attempts, in the order in which they were run.
+	URLs      []string
+	Artifacts []Artifact
+}
+
+// ArtifactType represents the type of artifact that a reporter may require.
+type ArtifactType int
+
+// The different types of artifacts that reporters may require.
+const (
+	JUnitArtifact ArtifactType = iota
+)
+
+// Artifact represents an artifact (aka asset) that was generated as part of a job.
+type Artifact struct {
+	AssetType ArtifactType
+	Body      []byte
 }
 
 // Reporter is the interface for rest result reporting.
@@ -20,4 +44,19 @@ type Reporter interface {
 	Render()
 	// Reset resets the state of the reporter (e.g. remove any previously reported TestResults).
 	Reset()
+	// ArtifactRequirements returns a list of artifact types that this reporter requires to create a proper report.
+	ArtifactRequirements() []ArtifactType
+}
+
+// IsArtifactRequired traverses the list of reporters and validates their requirements against the given artifact type.
+func IsArtifactRequired(reps []Reporter, at ArtifactType) bool {
+	for _, r := range reps {
+		for _, ar := range r.ArtifactRequirements() {
+			if ar == at {
+				return true
+			}
+		}
+	}
+
+	return false
 }
@@ -69,6 +69,11 @@ func (r *Reporter) Render() {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
+	// Nothing to summarize if the run failed before any suite was started.
+	if len(r.TestResults) == 0 {
+		return
+	}
+
 	t := table.NewWriter()
 	t.SetOutputMirror(r.Dst)
 	t.SetStyle(defaultTableStyle)
@@ -92,8 +97,14 @@ func (r *Reporter) Render() {
 	})
 
 	errors := 0
+	tests := 0
 	var totalDur time.Duration
 	for _, ts := range r.TestResults {
+		// Skipped suites never ran, so there is nothing to summarize.
+		if ts.Skipped {
+			continue
+		}
+		tests++
 		if !ts.Passed {
 			errors++
 		}
@@ -102,10 +113,10 @@ func (r *Reporter) Render() {
 
 		// the order of values must match the order of the header
 		t.AppendRow(table.Row{statusSymbol(ts.Passed), ts.Name, ts.Duration.Truncate(1 * time.Second),
-			statusText(ts.Passed), ts.Browser, ts.Platform, ts.DeviceName})
+			statusText(ts), ts.Browser, ts.Platform, ts.DeviceName})
 	}
 
-	t.AppendFooter(footer(errors, len(r.TestResults), totalDur))
+	t.AppendFooter(footer(errors, tests, totalDur))
 
 	_, _ = fmt.Fprintln(r.Dst)
 	t.Render()
@@ -118,6 +129,11 @@ func (r *Reporter) Reset() {
 	r.TestResults = make([]report.TestResult, 0)
 }
 
+// ArtifactRequirements returns a list of artifact types that are requested by this reporter.
+func (r *Reporter) ArtifactRequirements() []report.ArtifactType {
+	return nil
+}
+
 func footer(errors, tests int, dur time.Duration) table.Row {
 	symbol := statusSymbol(errors == 0)
 	if errors != 0 {
@@ -127,8 +143,11 @@ func footer(errors, tests int, dur time.Duration) table.Row {
 	return table.Row{symbol, "All tests have passed", dur.Truncate(1 * time.Second)}
 }
 
-func statusText(passed bool) string {
-	if !passed {
+func statusText(ts report.TestResult) string {
+	if ts.TimedOut {
+		return color.RedString("timed out")
+	}
+	if !ts.Passed {
 		return color.RedString("failed")
 	}
 
@@ -77,6 +77,10 @@ func TestReporter_Render(t *testing.T) {
   ✖    1 of 2 suites have failed (50%)       3m25s                                     
 `,
 		},
+		{
+			name: "no results",
+			want: "",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -168,7 +172,7 @@ func TestReporter_Add(t *testing.T) {
 			if len(r.TestResults) != 1 {
 				t.Errorf("len(TestResults) got = %d, want = %d", len(r.TestResults), 1)
 			}
-			if r.TestResults[0] != tt.args.t {
+			if !reflect.DeepEqual(r.TestResults[0], tt.args.t) {
 				t.Errorf(" got = %v, want = %v", r.TestResults[0], tt.args.t)
 			}
 		})
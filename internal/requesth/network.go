@@ -0,0 +1,150 @@
+package requesth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// NetworkOptions configures how saucectl connects to Sauce Labs and any other service.
+type NetworkOptions struct {
+	// Proxy is the URL of the proxy that all requests go through. If empty, the proxy is determined by the HTTPS_PROXY,
+	// HTTP_PROXY and NO_PROXY environment variables.
+	Proxy string
+	// ProxyAuth is the username and password to authenticate with the proxy, separated by a colon.
+	ProxyAuth string
+	// CABundle is the path to a PEM file of certificate authorities that are trusted in addition to the system's.
+	CABundle string
+	// Insecure skips the verification of TLS certificates.
+	Insecure bool
+}
+
+var (
+	transportLock sync.RWMutex
+	transport     http.RoundTripper = http.DefaultTransport
+	options       NetworkOptions
+)
+
+// Options returns the options that were last passed to Configure.
+func Options() NetworkOptions {
+	transportLock.RLock()
+	defer transportLock.RUnlock()
+	return options
+}
+
+// Transport returns the transport that performs the requests of all clients created by NewClient, as set up by
+// Configure.
+func Transport() http.RoundTripper {
+	transportLock.RLock()
+	defer transportLock.RUnlock()
+	return transport
+}
+
+// ConfiguredTransport is an http.RoundTripper that performs every request with the transport returned by Transport at
+// the time of the request. Clients that are created before Configure is called, e.g. during startup, thus follow the
+// network settings just as well.
+type ConfiguredTransport struct{}
+
+// RoundTrip executes the request with the transport set up by Configure.
+func (ConfiguredTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return Transport().RoundTrip(req)
+}
+
+// Configure sets up the transport of all HTTP clients according to opts, and logs which proxy is in effect.
+func Configure(opts NetworkOptions) error {
+	t, err := newTransport(opts)
+	if err != nil {
+		return err
+	}
+
+	if p := proxyURL(t); p != "" {
+		log.Info().Str("proxy", p).Msg("Using proxy.")
+	} else {
+		log.Debug().Msg("Not using a proxy.")
+	}
+	if opts.CABundle != "" {
+		log.Info().Str("file", opts.CABundle).Msg("Trusting additional certificate authorities.")
+	}
+	if opts.Insecure {
+		log.Warn().Msg("TLS certificates are not verified. Only use this for diagnosing connection problems.")
+	}
+
+	transportLock.Lock()
+	defer transportLock.Unlock()
+	transport = t
+	options = opts
+	return nil
+}
+
+// newTransport returns a transport that connects according to opts.
+func newTransport(opts NetworkOptions) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	var user *url.Userinfo
+	if opts.ProxyAuth != "" {
+		parts := strings.SplitN(opts.ProxyAuth, ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.New("invalid proxy auth: expected 'username:password'")
+		}
+		user = url.UserPassword(parts[0], parts[1])
+	}
+
+	if opts.Proxy != "" {
+		u, err := url.Parse(opts.Proxy)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL '%s'", opts.Proxy)
+		}
+		if user != nil {
+			u.User = user
+		}
+		t.Proxy = http.ProxyURL(u)
+	} else if user != nil {
+		t.Proxy = func(req *http.Request) (*url.URL, error) {
+			u, err := http.ProxyFromEnvironment(req)
+			if u != nil {
+				u.User = user
+			}
+			return u, err
+		}
+	}
+
+	if opts.CABundle != "" || opts.Insecure {
+		t.TLSClientConfig = &tls.Config{InsecureSkipVerify: opts.Insecure}
+	}
+	if opts.CABundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := os.ReadFile(opts.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %v", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle '%s'", opts.CABundle)
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	return t, nil
+}
+
+// proxyURL returns the proxy that t uses to reach Sauce Labs, without credentials. Empty if there is none.
+func proxyURL(t *http.Transport) string {
+	if t.Proxy == nil {
+		return ""
+	}
+	u, err := t.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "api.us-west-1.saucelabs.com"}})
+	if err != nil || u == nil {
+		return ""
+	}
+	return u.Redacted()
+}
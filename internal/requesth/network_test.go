@@ -0,0 +1,132 @@
+package requesth
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfigure_Proxy(t *testing.T) {
+	var gotURL, gotAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL = r.URL.String()
+		gotAuth = r.Header.Get("Proxy-Authorization")
+	}))
+	defer proxy.Close()
+
+	if err := Configure(NetworkOptions{Proxy: proxy.URL, ProxyAuth: "user:secret"}); err != nil {
+		t.Fatalf("Configure() error = %v", err)
+	}
+	t.Cleanup(func() { _ = Configure(NetworkOptions{}) })
+
+	req, err := New(http.MethodGet, "http://saucelabs.invalid/rest", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := NewClient(0).Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if gotURL != "http://saucelabs.invalid/rest" {
+		t.Errorf("proxy got URL %q, want %q", gotURL, "http://saucelabs.invalid/rest")
+	}
+	wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	if gotAuth != wantAuth {
+		t.Errorf("proxy got Proxy-Authorization %q, want %q", gotAuth, wantAuth)
+	}
+	if got := Options().Proxy; got != proxy.URL {
+		t.Errorf("Options().Proxy = %q, want %q", got, proxy.URL)
+	}
+}
+
+func TestConfiguredTransport(t *testing.T) {
+	var proxied bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+	}))
+	defer proxy.Close()
+
+	// The client is created before the proxy is configured.
+	c := &http.Client{Transport: ConfiguredTransport{}}
+	if err := Configure(NetworkOptions{Proxy: proxy.URL}); err != nil {
+		t.Fatalf("Configure() error = %v", err)
+	}
+	t.Cleanup(func() { _ = Configure(NetworkOptions{}) })
+
+	resp, err := c.Get("http://saucelabs.invalid/rest")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if !proxied {
+		t.Error("request did not go through the proxy that was configured after the client was created")
+	}
+}
+
+func TestConfigure_TLS(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	if err := os.WriteFile(bundle, cert, 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = Configure(NetworkOptions{}) })
+
+	tests := []struct {
+		name    string
+		opts    NetworkOptions
+		wantErr bool
+	}{
+		{name: "untrusted certificate", opts: NetworkOptions{}, wantErr: true},
+		{name: "certificate in CA bundle", opts: NetworkOptions{CABundle: bundle}},
+		{name: "insecure", opts: NetworkOptions{Insecure: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Configure(tt.opts); err != nil {
+				t.Fatalf("Configure() error = %v", err)
+			}
+			c := &http.Client{Transport: &RetryTransport{}}
+			resp, err := c.Get(ts.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				resp.Body.Close()
+			}
+		})
+	}
+}
+
+func TestConfigure_Invalid(t *testing.T) {
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	if err := os.WriteFile(empty, []byte("no certificates"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		opts NetworkOptions
+	}{
+		{name: "proxy without host", opts: NetworkOptions{Proxy: "proxy:8080"}},
+		{name: "proxy auth without password", opts: NetworkOptions{Proxy: "http://proxy:8080", ProxyAuth: "user"}},
+		{name: "missing CA bundle", opts: NetworkOptions{CABundle: filepath.Join(t.TempDir(), "missing.pem")}},
+		{name: "CA bundle without certificates", opts: NetworkOptions{CABundle: empty}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Configure(tt.opts); err == nil {
+				t.Errorf("Configure() error = nil, want error")
+			}
+		})
+	}
+}
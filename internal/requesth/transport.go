@@ -0,0 +1,171 @@
+package requesth
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Defaults of RetryTransport.
+var (
+	DefaultRetries = 3
+	DefaultMinWait = 1 * time.Second
+	DefaultMaxWait = 30 * time.Second
+)
+
+// RetryTransport is an http.RoundTripper that retries requests that failed due to network errors, server errors
+// (status code >= 500) or rate limiting (status code 429), waiting exponentially longer in between attempts.
+// Requests that are not idempotent, such as POST requests, are only retried if the server cannot have processed them,
+// i.e. if the connection could not be established or the server responded with 429.
+type RetryTransport struct {
+	// Base is the transport that performs the requests. Defaults to the transport set up by Configure.
+	Base http.RoundTripper
+	// Retries is the number of times a failed request is retried.
+	Retries int
+	// MinWait is the time to wait before the first retry. It doubles with every retry, up to MaxWait. To spread
+	// retries, a random jitter of up to half the wait time is subtracted.
+	MinWait time.Duration
+	// MaxWait is the longest time to wait before a retry. Responses whose Retry-After header asks to wait longer are
+	// not retried.
+	MaxWait time.Duration
+}
+
+// NewClient returns an http.Client that retries failed requests with the default settings of RetryTransport.
+// The timeout covers the request, including all retries.
+func NewClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &RetryTransport{
+			Retries: DefaultRetries,
+			MinWait: DefaultMinWait,
+			MaxWait: DefaultMaxWait,
+		},
+	}
+}
+
+// RoundTrip executes the request, retrying it if needed.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = Transport()
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := base.RoundTrip(req)
+		if attempt >= t.Retries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt)
+		if resp != nil {
+			if ra, ok := retryAfter(resp); ok {
+				if ra > t.MaxWait {
+					return resp, err
+				}
+				if ra > wait {
+					wait = ra
+				}
+			}
+		}
+
+		// The request body has been consumed by the previous attempt and needs to be recreated.
+		if req.Body != nil && req.Body != http.NoBody {
+			body, berr := req.GetBody()
+			if berr != nil {
+				return resp, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		l := log.Warn().Str("method", req.Method).Str("url", req.URL.Redacted()).Int("attempt", attempt+1).Dur("wait", wait)
+		if resp != nil {
+			l = l.Int("status", resp.StatusCode)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		l.Err(err).Msg("Request failed, retrying.")
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry returns whether the failed request req may succeed when it's retried.
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	// Bodies that cannot be recreated, e.g. streams, cannot be sent again.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req) || isDialError(err)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError && isIdempotent(req)
+}
+
+// backoff returns the time to wait before the given retry attempt, starting at 0.
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	wait := t.MinWait
+	for i := 0; i < attempt && wait < t.MaxWait; i++ {
+		wait *= 2
+	}
+	if wait > t.MaxWait {
+		wait = t.MaxWait
+	}
+	if wait <= 1 {
+		return wait
+	}
+	return wait - time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// isIdempotent returns whether sending req more than once has the same effect as sending it once.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// isDialError returns whether err occurred while connecting to the server, i.e. before the request was sent.
+func isDialError(err error) bool {
+	var oe *net.OpError
+	return errors.As(err, &oe) && oe.Op == "dial"
+}
+
+// retryAfter returns the duration that the Retry-After header of resp asks to wait, if any.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
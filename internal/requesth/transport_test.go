@@ -0,0 +1,177 @@
+package requesth
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		header       http.Header
+		statuses     []int
+		retryAfter   string
+		wantStatus   int
+		wantAttempts int
+	}{
+		{
+			name:         "retries server errors",
+			method:       http.MethodGet,
+			statuses:     []int{500, 502, 200},
+			wantStatus:   200,
+			wantAttempts: 3,
+		},
+		{
+			name:         "gives up after retries",
+			method:       http.MethodGet,
+			statuses:     []int{500, 500, 500, 500, 500},
+			wantStatus:   500,
+			wantAttempts: 4,
+		},
+		{
+			name:         "does not retry client errors",
+			method:       http.MethodGet,
+			statuses:     []int{404, 200},
+			wantStatus:   404,
+			wantAttempts: 1,
+		},
+		{
+			name:         "does not retry non-idempotent requests",
+			method:       http.MethodPost,
+			statuses:     []int{500, 200},
+			wantStatus:   500,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries non-idempotent requests with idempotency key",
+			method:       http.MethodPost,
+			header:       http.Header{"Idempotency-Key": []string{"abc"}},
+			statuses:     []int{503, 201},
+			wantStatus:   201,
+			wantAttempts: 2,
+		},
+		{
+			name:         "retries rate limited non-idempotent requests",
+			method:       http.MethodPost,
+			statuses:     []int{429, 201},
+			retryAfter:   "0",
+			wantStatus:   201,
+			wantAttempts: 2,
+		},
+		{
+			name:         "does not wait longer than max wait",
+			method:       http.MethodGet,
+			statuses:     []int{429, 200},
+			retryAfter:   "3600",
+			wantStatus:   429,
+			wantAttempts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != "payload" {
+					t.Errorf("attempt %d got body %q, want %q", attempts, body, "payload")
+				}
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.statuses[attempts])
+				attempts++
+			}))
+			defer ts.Close()
+
+			c := &http.Client{Transport: &RetryTransport{Retries: 3, MinWait: time.Millisecond, MaxWait: 10 * time.Millisecond}}
+			req, err := New(tt.method, ts.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+
+			resp, err := c.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode got = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts got = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_RoundTrip_DialError(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	url := ts.URL
+	ts.Close()
+
+	c := &http.Client{Transport: &RetryTransport{Retries: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond}}
+	req, err := New(http.MethodPost, url, strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Do(req); !isDialError(err) {
+		t.Errorf("Do() error = %v, want dial error", err)
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	tr := &RetryTransport{MinWait: time.Second, MaxWait: 5 * time.Second}
+
+	tests := []struct {
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{attempt: 0, min: 500 * time.Millisecond, max: time.Second},
+		{attempt: 1, min: time.Second, max: 2 * time.Second},
+		{attempt: 2, min: 2 * time.Second, max: 4 * time.Second},
+		{attempt: 3, min: 2500 * time.Millisecond, max: 5 * time.Second},
+		{attempt: 10, min: 2500 * time.Millisecond, max: 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := tr.backoff(tt.attempt); got < tt.min || got > tt.max {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", tt.attempt, got, tt.min, tt.max)
+		}
+	}
+}
+
+func Test_retryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "missing", header: ""},
+		{name: "seconds", header: "120", want: 120 * time.Second, wantOK: true},
+		{name: "date in the past", header: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOK: true},
+		{name: "invalid", header: "soon"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+			got, ok := retryAfter(resp)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("retryAfter() = %s, %t, want %s, %t", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
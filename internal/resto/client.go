@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -62,7 +63,7 @@ type tunnel struct {
 // New creates a new client.
 func New(url, username, accessKey string, timeout time.Duration) Client {
 	return Client{
-		HTTPClient: &http.Client{Timeout: timeout},
+		HTTPClient: requesth.NewClient(timeout),
 		URL:        url,
 		Username:   username,
 		AccessKey:  accessKey,
@@ -89,7 +90,13 @@ func (c *Client) PollJob(ctx context.Context, id string, interval time.Duration)
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
-	for range ticker.C {
+	for {
+		select {
+		case <-ctx.Done():
+			return job.Job{}, ctx.Err()
+		case <-ticker.C:
+		}
+
 		j, err := doRequest(c.HTTPClient, request)
 		if err != nil {
 			return job.Job{}, err
@@ -99,8 +106,6 @@ func (c *Client) PollJob(ctx context.Context, id string, interval time.Duration)
 			return j, nil
 		}
 	}
-
-	return job.Job{}, nil
 }
 
 // GetJobAssetFileNames return the job assets list.
@@ -201,6 +206,44 @@ func (c *Client) isTunnelRunning(ctx context.Context, id string) error {
 	return ErrTunnelNotFound
 }
 
+// ListJobs returns the most recent jobs, up to limit.
+func (c *Client) ListJobs(ctx context.Context, limit int) ([]job.Job, error) {
+	req, err := requesth.NewWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/rest/v1.1/%s/jobs", c.URL, c.Username), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Username, c.AccessKey)
+
+	q := req.URL.Query()
+	q.Add("limit", strconv.Itoa(limit))
+	q.Add("full", "true")
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, ErrServerError
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		err := fmt.Errorf("job list request failed; unexpected response code:'%d', msg:'%v'", resp.StatusCode, string(body))
+		return nil, err
+	}
+
+	var jobs []job.Job
+	if err := json.NewDecoder(resp.Body).Decode(&jobs); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
 // StopJob stops the job on the Sauce Cloud.
 func (c *Client) StopJob(ctx context.Context, id string) (job.Job, error) {
 	request, err := createStopRequest(ctx, c.URL, c.Username, c.AccessKey, id)
@@ -359,33 +402,38 @@ func createStopRequest(ctx context.Context, url, username, accessKey, jobID stri
 	return req, nil
 }
 
-// DownloadArtifact does downloading artifacts
-func (c *Client) DownloadArtifact(jobID string) {
-	targetDir := filepath.Join(c.ArtifactConfig.Directory, jobID)
-	if err := os.MkdirAll(targetDir, 0755); err != nil {
-		log.Error().Msgf("Unable to create %s to fetch artifacts (%v)", targetDir, err)
-		return
-	}
-
-	files, err := c.GetJobAssetFileNames(context.Background(), jobID)
+// DownloadArtifact downloads the artifacts of the job that match the ArtifactConfig into a folder named after the job.
+// The folder is only created if there is anything to download.
+func (c *Client) DownloadArtifact(ctx context.Context, jobID string) error {
+	files, err := c.GetJobAssetFileNames(ctx, jobID)
 	if err != nil {
-		log.Error().Msgf("Unable to fetch artifacts list (%v)", err)
-		return
+		return fmt.Errorf("unable to fetch artifacts list: %w", err)
 	}
+
+	targetDir := filepath.Join(c.ArtifactConfig.Directory, jobID)
+	failed := 0
 	for _, f := range files {
 		for _, pattern := range c.ArtifactConfig.Match {
 			if glob.Glob(pattern, f) {
-				if err := c.downloadArtifact(targetDir, jobID, f); err != nil {
+				if err := os.MkdirAll(targetDir, 0755); err != nil {
+					return fmt.Errorf("unable to create %s to fetch artifacts: %w", targetDir, err)
+				}
+				if err := c.downloadArtifact(ctx, targetDir, jobID, f); err != nil {
 					log.Error().Err(err).Msgf("Failed to download file: %s", f)
+					failed++
 				}
 				break
 			}
 		}
 	}
+	if failed > 0 {
+		return fmt.Errorf("failed to download %d artifact(s) of job %s", failed, jobID)
+	}
+	return nil
 }
 
-func (c *Client) downloadArtifact(targetDir, jobID, fileName string) error {
-	content, err := c.GetJobAssetFileContent(context.Background(), jobID, fileName)
+func (c *Client) downloadArtifact(ctx context.Context, targetDir, jobID, fileName string) error {
+	content, err := c.GetJobAssetFileContent(ctx, jobID, fileName)
 	if err != nil {
 		return err
 	}
@@ -14,8 +14,14 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/saucelabs/saucectl/internal/job"
+	"github.com/saucelabs/saucectl/internal/requesth"
 )
 
+func init() {
+	// Failed requests are retried, which tests should not have to wait for.
+	requesth.DefaultMinWait = 0
+}
+
 func TestClient_GetJobDetails(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -200,6 +206,21 @@ func TestClient_GetJobStatus(t *testing.T) {
 	}
 }
 
+func TestClient_PollJob_Cancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := json.Marshal(job.Job{ID: "1", Status: "in progress"})
+		w.Write(resp)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := New(ts.URL, "test", "123", 3*time.Second)
+	_, err := client.PollJob(ctx, "1", 10*time.Millisecond)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got error %v", err)
+}
+
 func TestClient_GetJobAssetFileNames(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -414,3 +435,23 @@ func TestClient_TestStop(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ListJobs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/v1.1/test/jobs" || r.URL.Query().Get("limit") != "2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[{"id": "1", "name": "chrome", "status": "complete", "passed": true, "error": null},
+			{"id": "2", "name": "firefox", "status": "in progress", "passed": null, "error": null}]`))
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL, "test", "123", 3*time.Second)
+	jobs, err := client.ListJobs(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []job.Job{
+		{ID: "1", Name: "chrome", Status: "complete", Passed: true},
+		{ID: "2", Name: "firefox", Status: "in progress"},
+	}, jobs)
+}
@@ -0,0 +1,127 @@
+package saucecloud
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/internal/archive/zip"
+	"github.com/saucelabs/saucectl/internal/bytesize"
+	"github.com/saucelabs/saucectl/internal/sauceignore"
+)
+
+// bundleCulprits are sauceignore patterns of files that commonly bloat project bundles, although tests rarely need them.
+var bundleCulprits = []string{".git/", "node_modules/.cache/", "*.mp4", "*.mov", "*.webm", "*.avi"}
+
+// bundleReportSize is the number of directories and files that the bundle report lists.
+var bundleReportSize = 5
+
+// bundleItem represents a file, or all files matching a name or pattern, within a project bundle.
+type bundleItem struct {
+	name  string
+	size  int64
+	files int
+}
+
+// bundleSummary represents the largest items within a project bundle.
+type bundleSummary struct {
+	// dirs are the largest top-level directories.
+	dirs []bundleItem
+	// files are the largest files.
+	files []bundleItem
+	// culprits are the files that match bundleCulprits, grouped by pattern.
+	culprits []bundleItem
+}
+
+// summarizeBundle returns the n largest directories and files among entries, as well as any culprits.
+func summarizeBundle(entries []zip.Entry, n int) bundleSummary {
+	var s bundleSummary
+
+	dirs := map[string]*bundleItem{}
+	culprits := map[string]*bundleItem{}
+	matchers := map[string]sauceignore.Matcher{}
+	for _, p := range bundleCulprits {
+		matchers[p] = sauceignore.NewMatcher([]sauceignore.Pattern{sauceignore.NewPattern(p)})
+	}
+
+	for _, e := range entries {
+		s.files = append(s.files, bundleItem{name: e.Name, size: e.Size, files: 1})
+
+		parts := strings.Split(e.Name, "/")
+		if len(parts) > 1 {
+			name := parts[0] + "/"
+			if dirs[name] == nil {
+				dirs[name] = &bundleItem{name: name}
+			}
+			dirs[name].size += e.Size
+			dirs[name].files++
+		}
+
+		for _, p := range bundleCulprits {
+			if !matchers[p].Match(parts, false) {
+				continue
+			}
+			if culprits[p] == nil {
+				culprits[p] = &bundleItem{name: p}
+			}
+			culprits[p].size += e.Size
+			culprits[p].files++
+		}
+	}
+
+	for _, d := range dirs {
+		s.dirs = append(s.dirs, *d)
+	}
+	// Culprits retain the order of bundleCulprits.
+	for _, p := range bundleCulprits {
+		if c := culprits[p]; c != nil {
+			s.culprits = append(s.culprits, *c)
+		}
+	}
+
+	s.dirs = largest(s.dirs, n)
+	s.files = largest(s.files, n)
+	return s
+}
+
+// largest returns the n largest items, largest first.
+func largest(items []bundleItem, n int) []bundleItem {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].size == items[j].size {
+			return items[i].name < items[j].name
+		}
+		return items[i].size > items[j].size
+	})
+	if len(items) > n {
+		items = items[:n]
+	}
+	return items
+}
+
+// reportBundle prints the largest directories and files of the project bundle that consists of entries, and warns
+// about files that tests rarely need.
+func reportBundle(entries []zip.Entry) {
+	s := summarizeBundle(entries, bundleReportSize)
+
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(table.StyleLight)
+	t.AppendHeader(table.Row{"Largest in bundle", "Size", "Files"})
+	for _, d := range s.dirs {
+		t.AppendRow(table.Row{d.name, bytesize.Format(d.size), d.files})
+	}
+	if len(s.dirs) > 0 {
+		t.AppendSeparator()
+	}
+	for _, f := range s.files {
+		t.AppendRow(table.Row{f.name, bytesize.Format(f.size), f.files})
+	}
+	t.Render()
+
+	for _, c := range s.culprits {
+		log.Warn().Str("size", bytesize.Format(c.size)).Int("files", c.files).
+			Msgf("The project bundle contains %s, which tests rarely need. Consider adding it to .sauceignore.", c.name)
+	}
+}
@@ -0,0 +1,36 @@
+package saucecloud
+
+import (
+	"testing"
+
+	"github.com/saucelabs/saucectl/internal/archive/zip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeBundle(t *testing.T) {
+	entries := []zip.Entry{
+		{Name: "sauce-runner.json", Size: 10},
+		{Name: ".git/objects/pack/big.pack", Size: 500},
+		{Name: ".git/HEAD", Size: 1},
+		{Name: "node_modules/.cache/babel/x.json", Size: 300},
+		{Name: "node_modules/lodash/index.js", Size: 200},
+		{Name: "cypress/videos/login.spec.js.mp4", Size: 400},
+		{Name: "cypress/integration/login.spec.js", Size: 20},
+	}
+
+	s := summarizeBundle(entries, 2)
+
+	assert.Equal(t, []bundleItem{
+		{name: ".git/", size: 501, files: 2},
+		{name: "node_modules/", size: 500, files: 2},
+	}, s.dirs)
+	assert.Equal(t, []bundleItem{
+		{name: ".git/objects/pack/big.pack", size: 500, files: 1},
+		{name: "cypress/videos/login.spec.js.mp4", size: 400, files: 1},
+	}, s.files)
+	assert.Equal(t, []bundleItem{
+		{name: ".git/", size: 501, files: 2},
+		{name: "node_modules/.cache/", size: 300, files: 1},
+		{name: "*.mp4", size: 400, files: 1},
+	}, s.culprits)
+}
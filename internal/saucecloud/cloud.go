@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"os/signal"
 	"path/filepath"
 	"strings"
 	"time"
@@ -14,18 +13,17 @@ import (
 	ptable "github.com/jedib0t/go-pretty/v6/table"
 	"github.com/saucelabs/saucectl/internal/espresso"
 	"github.com/saucelabs/saucectl/internal/report"
-	"github.com/saucelabs/saucectl/internal/report/table"
 
 	"github.com/fatih/color"
 	"github.com/rs/zerolog/log"
 	"github.com/saucelabs/saucectl/internal/archive/zip"
+	"github.com/saucelabs/saucectl/internal/bytesize"
 	"github.com/saucelabs/saucectl/internal/concurrency"
 	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/download"
 	"github.com/saucelabs/saucectl/internal/job"
 	"github.com/saucelabs/saucectl/internal/jsonio"
 	"github.com/saucelabs/saucectl/internal/junit"
-	"github.com/saucelabs/saucectl/internal/progress"
 	"github.com/saucelabs/saucectl/internal/region"
 	"github.com/saucelabs/saucectl/internal/sauceignore"
 	"github.com/saucelabs/saucectl/internal/storage"
@@ -34,21 +32,27 @@ import (
 
 // CloudRunner represents the cloud runner for the Sauce Labs cloud.
 type CloudRunner struct {
+	// Ctx is the context of the run. Once it's done, e.g. because the user interrupted the run, suites that haven't
+	// started yet are skipped and those in progress are stopped.
+	Ctx                   context.Context
 	ProjectUploader       storage.ProjectUploader
 	JobStarter            job.Starter
 	JobReader             job.Reader
 	RDCJobReader          job.RDCReader
 	JobWriter             job.Writer
 	JobStopper            job.Stopper
+	RDCJobStopper         job.Stopper
 	CCYReader             concurrency.Reader
 	TunnelService         tunnel.Service
 	Region                region.Region
 	ShowConsoleLog        bool
 	ArtifactDownloader    download.ArtifactDownloader
 	RDCArtifactDownloader download.ArtifactDownloader
+	// Reporters receive the result of every suite. The caller renders them once all suites of the run, including
+	// those run in docker, are done.
+	Reporters []report.Reporter
 
-	interrupted bool
-	DryRun      bool
+	DryRun bool
 }
 
 type result struct {
@@ -58,11 +62,20 @@ type result struct {
 	skipped  bool
 	err      error
 	duration time.Duration
+	attempts int
+	jobURLs  []string
 }
 
 // ConsoleLogAsset represents job asset log file name.
 const ConsoleLogAsset = "console.log"
 
+// stopTimeout is how long to wait for a job to be stopped once the run is interrupted or the suite has timed out.
+var stopTimeout = 30 * time.Second
+
+// fetchTimeout is how long fetching the assets of a finished job may take. The fetch doesn't use the run's context, so
+// that the assets of jobs that finished before the run was interrupted are still retrieved.
+var fetchTimeout = 5 * time.Minute
+
 func (r *CloudRunner) createWorkerPool(num int) (chan job.StartOptions, chan result, error) {
 	ccy := concurrency.Min(r.CCYReader, num)
 	if ccy == 0 {
@@ -88,11 +101,6 @@ func (r *CloudRunner) collectResults(artifactCfg config.ArtifactDownload, result
 	inProgress := expected
 	passed := true
 
-	reporter := table.Reporter{
-		TestResults: make([]report.TestResult, 0, expected),
-		Dst:         os.Stdout,
-	}
-
 	done := make(chan interface{})
 	go func() {
 		t := time.NewTicker(10 * time.Second)
@@ -115,56 +123,105 @@ func (r *CloudRunner) collectResults(artifactCfg config.ArtifactDownload, result
 		completed++
 		inProgress--
 
-		if !res.skipped {
-			platform := res.job.BaseConfig.PlatformName
-			if res.job.BaseConfig.PlatformVersion != "" {
-				platform = fmt.Sprintf("%s %s", platform, res.job.BaseConfig.PlatformVersion)
-			}
+		platform := res.job.BaseConfig.PlatformName
+		if res.job.BaseConfig.PlatformVersion != "" {
+			platform = fmt.Sprintf("%s %s", platform, res.job.BaseConfig.PlatformVersion)
+		}
 
-			reporter.Add(report.TestResult{
-				Name:       res.name,
-				Duration:   res.duration,
-				Passed:     res.job.Passed,
-				Browser:    res.browser,
-				Platform:   platform,
-				DeviceName: res.job.BaseConfig.DeviceName,
-			})
+		var url string
+		if res.job.ID != "" {
+			url = r.jobDetailsPage(res.job.ID)
+		}
+		var errMsg string
+		if res.err != nil {
+			errMsg = res.err.Error()
 		}
 
-		if download.ShouldDownloadArtifact(res.job.ID, res.job.Passed, artifactCfg) {
+		tr := report.TestResult{
+			Name:       res.name,
+			JobID:      res.job.ID,
+			URL:        url,
+			Duration:   res.duration,
+			Passed:     res.job.Passed,
+			Skipped:    res.skipped,
+			TimedOut:   res.job.TimedOut,
+			Error:      errMsg,
+			Browser:    res.browser,
+			Platform:   platform,
+			DeviceName: res.job.BaseConfig.DeviceName,
+			Attempts:   res.attempts,
+			URLs:       res.jobURLs,
+		}
+		if !res.skipped {
+			tr.Artifacts = r.loadArtifacts(res)
+		}
+		for _, rep := range r.Reporters {
+			rep.Add(tr)
+		}
+
+		if !res.skipped && download.ShouldDownloadArtifact(res.job.ID, res.job.Passed, artifactCfg) {
+			d := r.ArtifactDownloader
 			if res.job.IsRDC {
-				r.RDCArtifactDownloader.DownloadArtifact(res.job.ID)
-			} else {
-				r.ArtifactDownloader.DownloadArtifact(res.job.ID)
+				d = r.RDCArtifactDownloader
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+			if err := d.DownloadArtifact(ctx, res.job.ID); err != nil {
+				log.Error().Err(err).Str("suite", res.name).Msg("Failed to download artifacts.")
 			}
+			cancel()
 		}
 		r.logSuite(res)
 	}
 	close(done)
 
-	reporter.Render()
-
 	return passed
 }
 
+// loadArtifacts fetches the job assets that are required by the configured reporters.
+func (r *CloudRunner) loadArtifacts(res result) []report.Artifact {
+	var artifacts []report.Artifact
+	if res.job.ID == "" || !report.IsArtifactRequired(r.Reporters, report.JUnitArtifact) {
+		return artifacts
+	}
+
+	reader := r.JobReader
+	if res.job.IsRDC {
+		reader = r.RDCJobReader
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	content, err := reader.GetJobAssetFileContent(ctx, res.job.ID, junit.FileName)
+	if err != nil {
+		log.Warn().Err(err).Str("suite", res.name).Msg("Failed to retrieve the junit report.")
+		return artifacts
+	}
+
+	return append(artifacts, report.Artifact{AssetType: report.JUnitArtifact, Body: content})
+}
+
 func (r *CloudRunner) runJob(opts job.StartOptions) (j job.Job, interrupted bool, err error) {
 	log.Info().Str("suite", opts.DisplayName).Str("region", r.Region.String()).Msg("Starting suite.")
 
-	id, isRDC, err := r.JobStarter.StartJob(context.Background(), opts)
+	id, isRDC, err := r.JobStarter.StartJob(r.Ctx, opts)
 	if err != nil {
+		if r.Ctx.Err() != nil {
+			return job.Job{}, true, nil
+		}
 		return job.Job{}, false, err
 	}
 
 	if !isRDC {
 		r.uploadSauceConfig(id, opts.ConfigFilePath)
 	}
-	// os.Interrupt can arrive before the signal.Notify() is registered. In that case,
-	// if a soft exit is requested during startContainer phase, it gently exits.
-	if r.interrupted {
+	// The run may have been interrupted while the job was starting.
+	if r.Ctx.Err() != nil {
+		r.stopSuiteExecution(id, isRDC, opts.DisplayName)
 		return job.Job{}, true, nil
 	}
 
-	jobDetailsPage := fmt.Sprintf("%s/tests/%s", r.Region.AppBaseURL(), id)
+	jobDetailsPage := r.jobDetailsPage(id)
 	l := log.Info().Str("url", jobDetailsPage).Str("suite", opts.DisplayName).Str("platform", opts.PlatformName)
 	if opts.Framework == config.KindEspresso {
 		l.Str("deviceName", opts.DeviceName).Str("platformVersion", opts.PlatformVersion).Str("deviceId", opts.DeviceID)
@@ -173,16 +230,33 @@ func (r *CloudRunner) runJob(opts job.StartOptions) (j job.Job, interrupted bool
 	}
 	l.Msg("Suite started.")
 
+	ctx := r.Ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(r.Ctx, opts.Timeout)
+		defer cancel()
+	}
+
 	// High interval poll to not oversaturate the job reader with requests
 	if !isRDC {
-		sigChan := r.registerInterruptOnSignal(id, opts.DisplayName)
-		defer unregisterSignalCapture(sigChan)
-
-		j, err = r.JobReader.PollJob(context.Background(), id, 15*time.Second)
+		j, err = r.JobReader.PollJob(ctx, id, 15*time.Second)
 	} else {
-		j, err = r.RDCJobReader.PollJob(context.Background(), id, 15*time.Second)
+		j, err = r.RDCJobReader.PollJob(ctx, id, 15*time.Second)
 	}
 
+	if r.Ctx.Err() != nil {
+		r.stopSuiteExecution(id, isRDC, opts.DisplayName)
+		return job.Job{ID: id, IsRDC: isRDC}, true, nil
+	}
+	if ctx.Err() != nil {
+		log.Warn().Str("suite", opts.DisplayName).Dur("timeout", opts.Timeout).Msg("Suite timed out.")
+		r.stopSuiteExecution(id, isRDC, opts.DisplayName)
+		j = job.Job{ID: id, IsRDC: isRDC, TimedOut: true}
+		if isRDC {
+			enrichRDCReport(&j, opts)
+		}
+		return j, false, fmt.Errorf("suite '%s' has timed out after %s", opts.DisplayName, opts.Timeout)
+	}
 	if err != nil {
 		return job.Job{}, false, fmt.Errorf("failed to retrieve job status for suite %s", opts.DisplayName)
 	}
@@ -200,6 +274,11 @@ func (r *CloudRunner) runJob(opts job.StartOptions) (j job.Job, interrupted bool
 	return j, false, nil
 }
 
+// jobDetailsPage returns the URL of the job details page on Sauce Labs.
+func (r *CloudRunner) jobDetailsPage(jobID string) string {
+	return fmt.Sprintf("%s/tests/%s", r.Region.AppBaseURL(), jobID)
+}
+
 // enrichRDCReport added the fields from the opts as the API does not provides it.
 func enrichRDCReport(j *job.Job, opts job.StartOptions) {
 	switch opts.Framework {
@@ -219,7 +298,7 @@ func (r *CloudRunner) runJobs(jobOpts <-chan job.StartOptions, results chan<- re
 	for opts := range jobOpts {
 		start := time.Now()
 
-		if r.interrupted {
+		if r.Ctx.Err() != nil {
 			results <- result{
 				name:    opts.DisplayName,
 				browser: opts.BrowserName,
@@ -229,7 +308,25 @@ func (r *CloudRunner) runJobs(jobOpts <-chan job.StartOptions, results chan<- re
 			continue
 		}
 
-		jobData, skipped, err := r.runJob(opts)
+		var jobData job.Job
+		var skipped bool
+		var err error
+		var jobURLs []string
+		attempts := 0
+		for attempts <= opts.Retries {
+			if attempts > 0 {
+				log.Warn().Err(err).Str("suite", opts.DisplayName).Int("attempt", attempts+1).Msg("Retrying suite.")
+			}
+
+			attempts++
+			jobData, skipped, err = r.runJob(opts)
+			if jobData.ID != "" {
+				jobURLs = append(jobURLs, r.jobDetailsPage(jobData.ID))
+			}
+			if err == nil || skipped || r.Ctx.Err() != nil {
+				break
+			}
+		}
 
 		results <- result{
 			name:     opts.DisplayName,
@@ -238,18 +335,34 @@ func (r *CloudRunner) runJobs(jobOpts <-chan job.StartOptions, results chan<- re
 			skipped:  skipped,
 			err:      err,
 			duration: time.Since(start),
+			attempts: attempts,
+			jobURLs:  jobURLs,
 		}
 	}
 }
 
-func (r CloudRunner) archiveAndUpload(project interface{}, folder string, sauceignoreFile string) (string, error) {
+// bundledSauceConfig returns the part of sauce that is relevant to the runner within the project bundle. Settings that
+// saucectl passes to each job on its own, such as the build name or the tunnel, are left out. Changing them, e.g. on
+// every CI run, thus doesn't change the bundle, and a previous upload of it can be reused. Network settings only
+// concern saucectl.
+func bundledSauceConfig(sauce config.SauceConfig) config.SauceConfig {
+	sauce.Metadata = config.Metadata{}
+	sauce.Tunnel = config.Tunnel{}
+	sauce.Concurrency = 0
+	sauce.Retries = 0
+	sauce.SuiteTimeout = 0
+	sauce.Network = config.Network{}
+	return sauce
+}
+
+func (r CloudRunner) archiveAndUpload(project interface{}, folder string, sauce config.SauceConfig) (string, error) {
 	tempDir, err := os.MkdirTemp(os.TempDir(), "saucectl-app-payload")
 	if err != nil {
 		return "", err
 	}
 	defer os.RemoveAll(tempDir)
 
-	zipName, err := r.archiveProject(project, tempDir, folder, sauceignoreFile)
+	zipName, err := r.archiveProject(project, tempDir, folder, sauce)
 	if err != nil {
 		return "", err
 	}
@@ -257,16 +370,30 @@ func (r CloudRunner) archiveAndUpload(project interface{}, folder string, saucei
 	return r.uploadProject(zipName, projectUpload)
 }
 
-func (r *CloudRunner) archiveProject(project interface{}, tempDir string, projectFolder string, sauceignoreFile string) (string, error) {
+// archiveProject bundles the project in projectFolder, along with the runner config of the project, in tempDir. It
+// returns an error if the bundle exceeds the size limit set by sauce.
+//
+// The runner config can't be uploaded on its own: a job references a single uploaded app, which the runner unpacks
+// and reads sauce-runner.json from, to look up the suite that it's started with. Changing the suites therefore
+// changes the bundle, while settings that don't concern the runner are stripped by bundledSauceConfig.
+func (r *CloudRunner) archiveProject(project interface{}, tempDir string, projectFolder string, sauce config.SauceConfig) (string, error) {
 	start := time.Now()
 
-	matcher, err := sauceignore.NewMatcherFromFile(sauceignoreFile)
+	var maxSize int64
+	if sauce.MaxBundleSize != "" {
+		var err error
+		if maxSize, err = bytesize.Parse(sauce.MaxBundleSize); err != nil {
+			return "", fmt.Errorf("invalid sauce.maxBundleSize: %v", err)
+		}
+	}
+
+	matcher, err := sauceignore.NewMatcherFromDir(projectFolder, sauce.Sauceignore, sauceignore.Options{UseGitignore: sauce.UseGitignore})
 	if err != nil {
 		return "", err
 	}
 
 	zipName := filepath.Join(tempDir, "app.zip")
-	z, err := zip.NewFileWriter(zipName, matcher)
+	z, err := zip.NewFileWriter(zipName, matcher, zip.Options{PreserveSymlinks: sauce.PreserveSymlinks, MaxSize: maxSize})
 	if err != nil {
 		return "", err
 	}
@@ -277,33 +404,26 @@ func (r *CloudRunner) archiveProject(project interface{}, tempDir string, projec
 		return "", err
 	}
 
-	folderContent, err := os.ReadDir(projectFolder)
-	if err != nil {
-		return "", err
+	err = z.AddContents(projectFolder, "")
+	if err == nil {
+		log.Debug().Str("name", rcPath).Msg("Adding to archive")
+		err = z.Add(rcPath, "")
 	}
-
-	for _, child := range folderContent {
-		log.Debug().Str("name", child.Name()).Msg("Adding to archive")
-		if err := z.Add(filepath.Join(projectFolder, child.Name()), ""); err != nil {
-			return "", err
-		}
+	if err == nil {
+		err = z.Close()
 	}
-	log.Debug().Str("name", rcPath).Msg("Adding to archive")
-	if err := z.Add(rcPath, ""); err != nil {
-		return "", err
+	if errors.Is(err, zip.ErrTooLarge) {
+		// The files added so far show what takes up the most space.
+		reportBundle(z.Entries)
+		return "", fmt.Errorf("project bundle exceeds sauce.maxBundleSize of %s; use .sauceignore to exclude files that tests don't need",
+			bytesize.Format(maxSize))
 	}
-
-	err = z.Close()
 	if err != nil {
 		return "", err
 	}
 
-	f, err := os.Stat(zipName)
-	if err != nil {
-		return "", err
-	}
-
-	log.Info().Dur("durationMs", time.Since(start)).Int64("size", f.Size()).Msg("Project archived.")
+	log.Info().Dur("durationMs", time.Since(start)).Int64("size", z.Size()).Msg("Project archived.")
+	reportBundle(z.Entries)
 
 	return zipName, nil
 }
@@ -327,11 +447,10 @@ func (r *CloudRunner) uploadProject(filename string, pType uploadType) (string,
 	if err != nil {
 		return "", nil
 	}
-	progress.Show("Uploading %s %s", pType, filename)
+	log.Info().Msgf("Uploading %s %s", pType, filename)
 
 	start := time.Now()
-	resp, err := r.ProjectUploader.Upload(filename)
-	progress.Stop()
+	resp, err := r.ProjectUploader.Upload(r.Ctx, filename)
 	if err != nil {
 		return "", err
 	}
@@ -339,8 +458,23 @@ func (r *CloudRunner) uploadProject(filename string, pType uploadType) (string,
 	return resp.ID, nil
 }
 
+// uploadApp uploads the app at filename and returns a storage reference to it. If filename already is a storage
+// reference, e.g. "storage:<id>", it is returned as is and nothing is uploaded.
+func (r *CloudRunner) uploadApp(filename string, pType uploadType) (string, error) {
+	if storage.IsReference(filename) {
+		log.Info().Msgf("Skipping upload of %s, using %s", pType, filename)
+		return filename, nil
+	}
+
+	fileID, err := r.uploadProject(filename, pType)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s", storage.Prefix, fileID), nil
+}
+
 func (r *CloudRunner) checkIfFileAlreadyUploaded(fileName string) (storageID string, err error) {
-	resp, err := r.ProjectUploader.Find(fileName)
+	resp, err := r.ProjectUploader.Find(r.Ctx, fileName)
 	if err != nil {
 		return "", err
 	}
@@ -358,8 +492,10 @@ func (r *CloudRunner) logSuite(res result) {
 		return
 	}
 
-	jobDetailsPage := fmt.Sprintf("%s/tests/%s", r.Region.AppBaseURL(), res.job.ID)
-	if res.job.Passed {
+	jobDetailsPage := r.jobDetailsPage(res.job.ID)
+	if res.job.TimedOut {
+		log.Error().Str("suite", res.name).Str("url", jobDetailsPage).Msg("Suite timed out.")
+	} else if res.job.Passed {
 		log.Info().Str("suite", res.name).Bool("passed", res.job.Passed).Str("url", jobDetailsPage).
 			Msg("Suite finished.")
 	} else {
@@ -376,16 +512,19 @@ func (r *CloudRunner) logSuiteConsole(res result) {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
 	// Display log only when at least it has started
 	var assetContent []byte
 	var err error
-	if assetContent, err = r.JobReader.GetJobAssetFileContent(context.Background(), res.job.ID, ConsoleLogAsset); err == nil {
+	if assetContent, err = r.JobReader.GetJobAssetFileContent(ctx, res.job.ID, ConsoleLogAsset); err == nil {
 		log.Info().Str("suite", res.name).Msgf("console.log output: \n%s", assetContent)
 		return
 	}
 
 	// Some frameworks produce a junit.xml instead, check for that file if there's no console.log
-	assetContent, err = r.JobReader.GetJobAssetFileContent(context.Background(), res.job.ID, "junit.xml")
+	assetContent, err = r.JobReader.GetJobAssetFileContent(ctx, res.job.ID, junit.FileName)
 	if err != nil {
 		log.Warn().Str("suite", res.name).Msg("Failed to retrieve the console output.")
 		return
@@ -433,7 +572,7 @@ func (r *CloudRunner) validateTunnel(id string) error {
 	// This wait value is deliberately not configurable.
 	wait := 30 * time.Second
 	log.Info().Str("timeout", wait.String()).Msg("Performing tunnel readiness check...")
-	if err := r.TunnelService.IsTunnelRunning(context.Background(), id, wait); err != nil {
+	if err := r.TunnelService.IsTunnelRunning(r.Ctx, id, wait); err != nil {
 		return err
 	}
 
@@ -441,14 +580,14 @@ func (r *CloudRunner) validateTunnel(id string) error {
 	return nil
 }
 
-func (r *CloudRunner) dryRun(project interface{}, folder string, sauceIgnoreFile string, suiteNames string) error {
+func (r *CloudRunner) dryRun(project interface{}, folder string, sauce config.SauceConfig, suiteNames string) error {
 	log.Warn().Msg("Running tests in dry run mode.")
 	tmpDir, err := os.MkdirTemp("./", "sauce-app-payload-*")
 	if err != nil {
 		return err
 	}
 	log.Info().Msgf("The following test suites would have run: [%s].", suiteNames)
-	zipName, err := r.archiveProject(project, tmpDir, folder, sauceIgnoreFile)
+	zipName, err := r.archiveProject(project, tmpDir, folder, sauce)
 	if err != nil {
 		return err
 	}
@@ -457,55 +596,25 @@ func (r *CloudRunner) dryRun(project interface{}, folder string, sauceIgnoreFile
 	return nil
 }
 
-// stopSuiteExecution stops the current execution on Sauce Cloud
-func (r *CloudRunner) stopSuiteExecution(jobID string, suiteName string) {
-	_, err := r.JobStopper.StopJob(context.Background(), jobID)
-	if err != nil {
-		log.Warn().Err(err).Str("suite", suiteName).Msg("Unable to stop suite.")
+// stopSuiteExecution stops the current execution on Sauce Cloud. Since it's called once the run has been interrupted
+// or the suite has timed out, it doesn't use the context of the run.
+func (r *CloudRunner) stopSuiteExecution(jobID string, isRDC bool, suiteName string) {
+	stopper := r.JobStopper
+	if isRDC {
+		stopper = r.RDCJobStopper
+	}
+	if stopper == nil {
+		return
 	}
-}
-
-// registerInterruptOnSignal stops execution on Sauce Cloud when a SIGINT is captured.
-func (r *CloudRunner) registerInterruptOnSignal(jobID, suiteName string) chan os.Signal {
-	sigChan := make(chan os.Signal)
-	signal.Notify(sigChan, os.Interrupt)
-
-	go func(c <-chan os.Signal, jobID, suiteName string) {
-		sig := <-c
-		if sig == nil {
-			return
-		}
-		log.Info().Str("suite", suiteName).Msg("Stopping suite")
-		r.stopSuiteExecution(jobID, suiteName)
-	}(sigChan, jobID, suiteName)
-	return sigChan
-}
-
-// registerSkipSuitesOnSignal prevent new suites from being executed when a SIGINT is captured.
-func (r *CloudRunner) registerSkipSuitesOnSignal() chan os.Signal {
-	sigChan := make(chan os.Signal)
-	signal.Notify(sigChan, os.Interrupt)
 
-	go func(c <-chan os.Signal, cr *CloudRunner) {
-		for {
-			sig := <-c
-			if sig == nil {
-				return
-			}
-			if cr.interrupted {
-				os.Exit(1)
-			}
-			log.Info().Msg("Ctrl-C captured. Ctrl-C again to exit now.")
-			cr.interrupted = true
-		}
-	}(sigChan, r)
-	return sigChan
-}
+	log.Info().Str("suite", suiteName).Msg("Stopping suite")
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
 
-// unregisterSignalCapture remove the signal hook associated to the chan c.
-func unregisterSignalCapture(c chan os.Signal) {
-	signal.Stop(c)
-	close(c)
+	_, err := stopper.StopJob(ctx, jobID)
+	if err != nil {
+		log.Warn().Err(err).Str("suite", suiteName).Msg("Unable to stop suite.")
+	}
 }
 
 // uploadSauceConfig adds job configuration as an asset.
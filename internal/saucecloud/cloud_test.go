@@ -2,13 +2,12 @@ package saucecloud
 
 import (
 	"context"
-	"os"
-	"os/exec"
-	"syscall"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/saucelabs/saucectl/internal/concurrency"
+	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/job"
 	"github.com/saucelabs/saucectl/internal/mocks"
 	"github.com/saucelabs/saucectl/internal/region"
@@ -60,6 +59,7 @@ func TestCloudRunner_logSuiteConsole(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &CloudRunner{
+				Ctx:       context.Background(),
 				JobReader: tt.fields.JobReader,
 				JobWriter: tt.fields.JobWriter,
 			}
@@ -68,38 +68,6 @@ func TestCloudRunner_logSuiteConsole(t *testing.T) {
 	}
 }
 
-func TestSignalDetection(t *testing.T) {
-	r := CloudRunner{JobStopper: &mocks.FakeJobStopper{}}
-	assert.False(t, r.interrupted)
-	c := r.registerSkipSuitesOnSignal()
-	defer unregisterSignalCapture(c)
-
-	c <- syscall.SIGINT
-
-	time.Sleep(1 * time.Second)
-	assert.True(t, r.interrupted)
-}
-
-func TestSignalDetectionExit(t *testing.T) {
-	if os.Getenv("FORCE_EXIT_TEST") == "1" {
-		r := CloudRunner{JobStopper: &mocks.FakeJobStopper{}}
-		assert.False(t, r.interrupted)
-		c := r.registerSkipSuitesOnSignal()
-		defer unregisterSignalCapture(c)
-
-		c <- syscall.SIGINT
-		c <- syscall.SIGINT
-		return
-	}
-	cmd := exec.Command(os.Args[0], "-test.run=TestSignalDetectionExit")
-	cmd.Env = append(os.Environ(), "FORCE_EXIT_TEST=1")
-	err := cmd.Run()
-	if e, ok := err.(*exec.ExitError); ok && !e.Success() {
-		return
-	}
-	t.Fatalf("process ran with err %v, want exit status 1", err)
-}
-
 func TestSkippedRunJobs(t *testing.T) {
 	type testCase struct {
 		interrupted bool
@@ -116,7 +84,13 @@ func TestSkippedRunJobs(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
+		ctx, cancel := context.WithCancel(context.Background())
+		if tt.interrupted {
+			cancel()
+		}
+		stopped := false
 		r := CloudRunner{
+			Ctx: ctx,
 			JobStarter: &mocks.FakeJobStarter{
 				StartJobFn: func(ctx context.Context, opts job.StartOptions) (jobID string, isRDC bool, err error) {
 					return "fake-id", false, nil
@@ -137,19 +111,116 @@ func TestSkippedRunJobs(t *testing.T) {
 					return nil
 				},
 			},
+			JobStopper: &mocks.FakeJobStopper{
+				StopJobFn: func(ctx context.Context, jobID string) (job.Job, error) {
+					stopped = true
+					return job.Job{ID: jobID}, ctx.Err()
+				},
+			},
 		}
-		r.interrupted = tt.interrupted
 
 		j, skipped, err := r.runJob(job.StartOptions{})
+		cancel()
 		assert.Equal(t, tt.wantSkipped, skipped)
 		assert.Equal(t, tt.wantErr, err != nil)
 		assert.Equal(t, tt.wantJobID, j.ID != "")
+		assert.Equal(t, tt.interrupted, stopped)
+	}
+}
+
+func TestRunJobInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := ""
+	r := CloudRunner{
+		Ctx: ctx,
+		JobStarter: &mocks.FakeJobStarter{
+			StartJobFn: func(ctx context.Context, opts job.StartOptions) (jobID string, isRDC bool, err error) {
+				return "fake-id", false, nil
+			},
+		},
+		JobReader: &mocks.FakeJobReader{
+			PollJobFn: func(ctx context.Context, id string, interval time.Duration) (job.Job, error) {
+				// The run is interrupted while the job is in progress.
+				cancel()
+				return job.Job{}, ctx.Err()
+			},
+		},
+		JobWriter: &mocks.FakeJobWriter{
+			UploadAssetFn: func(jobID string, fileName string, contentType string, content []byte) error {
+				return nil
+			},
+		},
+		JobStopper: &mocks.FakeJobStopper{
+			StopJobFn: func(ctx context.Context, jobID string) (job.Job, error) {
+				stopped = jobID
+				return job.Job{ID: jobID}, ctx.Err()
+			},
+		},
+	}
+
+	j, skipped, err := r.runJob(job.StartOptions{})
+	assert.Nil(t, err)
+	assert.True(t, skipped)
+	assert.Equal(t, "fake-id", j.ID)
+	assert.Equal(t, "fake-id", stopped)
+}
+
+func TestRunJobTimedOut(t *testing.T) {
+	for _, isRDC := range []bool{false, true} {
+		var stopped, rdcStopped string
+		poll := func(ctx context.Context, id string, interval time.Duration) (job.Job, error) {
+			// The job hangs until the suite times out.
+			<-ctx.Done()
+			return job.Job{}, ctx.Err()
+		}
+		r := CloudRunner{
+			Ctx: context.Background(),
+			JobStarter: &mocks.FakeJobStarter{
+				StartJobFn: func(ctx context.Context, opts job.StartOptions) (jobID string, rdc bool, err error) {
+					return "fake-id", isRDC, nil
+				},
+			},
+			JobReader:    &mocks.FakeJobReader{PollJobFn: poll},
+			RDCJobReader: &mocks.FakeRDCJobReader{FakeJobReader: mocks.FakeJobReader{PollJobFn: poll}},
+			JobWriter: &mocks.FakeJobWriter{
+				UploadAssetFn: func(jobID string, fileName string, contentType string, content []byte) error {
+					return nil
+				},
+			},
+			JobStopper: &mocks.FakeJobStopper{
+				StopJobFn: func(ctx context.Context, jobID string) (job.Job, error) {
+					stopped = jobID
+					return job.Job{ID: jobID}, nil
+				},
+			},
+			RDCJobStopper: &mocks.FakeJobStopper{
+				StopJobFn: func(ctx context.Context, jobID string) (job.Job, error) {
+					rdcStopped = jobID
+					return job.Job{ID: jobID}, nil
+				},
+			},
+		}
+
+		j, skipped, err := r.runJob(job.StartOptions{DisplayName: "suite", Timeout: 10 * time.Millisecond})
+		assert.EqualError(t, err, "suite 'suite' has timed out after 10ms")
+		assert.False(t, skipped)
+		assert.True(t, j.TimedOut)
+		assert.Equal(t, "fake-id", j.ID)
+		if isRDC {
+			assert.Equal(t, "fake-id", rdcStopped)
+			assert.Empty(t, stopped)
+		} else {
+			assert.Equal(t, "fake-id", stopped)
+			assert.Empty(t, rdcStopped)
+		}
 	}
 }
 
 func TestRunJobsSkipped(t *testing.T) {
-	r := CloudRunner{}
-	r.interrupted = true
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := CloudRunner{Ctx: ctx}
 
 	opts := make(chan job.StartOptions)
 	results := make(chan result)
@@ -161,3 +232,115 @@ func TestRunJobsSkipped(t *testing.T) {
 	assert.Nil(t, res.err)
 	assert.True(t, res.skipped)
 }
+
+func TestRunJobsRetries(t *testing.T) {
+	attempts := 0
+	r := CloudRunner{
+		Ctx:    context.Background(),
+		Region: region.USWest1,
+		JobStarter: &mocks.FakeJobStarter{
+			StartJobFn: func(ctx context.Context, opts job.StartOptions) (jobID string, isRDC bool, err error) {
+				attempts++
+				return fmt.Sprintf("fake-id-%d", attempts), false, nil
+			},
+		},
+		JobReader: &mocks.FakeJobReader{
+			PollJobFn: func(ctx context.Context, id string, interval time.Duration) (job.Job, error) {
+				// Only the third attempt passes.
+				return job.Job{ID: id, Passed: attempts == 3, Status: job.StateComplete}, nil
+			},
+		},
+		JobWriter: &mocks.FakeJobWriter{
+			UploadAssetFn: func(jobID string, fileName string, contentType string, content []byte) error {
+				return nil
+			},
+		},
+	}
+
+	opts := make(chan job.StartOptions)
+	results := make(chan result)
+
+	go r.runJobs(opts, results)
+	opts <- job.StartOptions{DisplayName: "dummy", Retries: 5}
+	close(opts)
+	res := <-results
+
+	assert.Nil(t, res.err)
+	assert.True(t, res.job.Passed)
+	assert.Equal(t, 3, res.attempts)
+	assert.Equal(t, []string{
+		"https://app.saucelabs.com/tests/fake-id-1",
+		"https://app.saucelabs.com/tests/fake-id-2",
+		"https://app.saucelabs.com/tests/fake-id-3",
+	}, res.jobURLs)
+}
+
+func TestRunJobsRetries_AllFail(t *testing.T) {
+	attempts := 0
+	r := CloudRunner{
+		Ctx:    context.Background(),
+		Region: region.USWest1,
+		JobStarter: &mocks.FakeJobStarter{
+			StartJobFn: func(ctx context.Context, opts job.StartOptions) (jobID string, isRDC bool, err error) {
+				attempts++
+				return fmt.Sprintf("fake-id-%d", attempts), false, nil
+			},
+		},
+		JobReader: &mocks.FakeJobReader{
+			PollJobFn: func(ctx context.Context, id string, interval time.Duration) (job.Job, error) {
+				return job.Job{ID: id, Passed: false, Status: job.StateComplete}, nil
+			},
+		},
+		JobWriter: &mocks.FakeJobWriter{
+			UploadAssetFn: func(jobID string, fileName string, contentType string, content []byte) error {
+				return nil
+			},
+		},
+	}
+
+	opts := make(chan job.StartOptions)
+	results := make(chan result, 2)
+
+	go r.runJobs(opts, results)
+	opts <- job.StartOptions{DisplayName: "dummy", Retries: 2}
+	opts <- job.StartOptions{DisplayName: "no retries"}
+	close(opts)
+
+	res := <-results
+	assert.False(t, res.job.Passed)
+	assert.Equal(t, 3, res.attempts)
+	assert.Len(t, res.jobURLs, 3)
+
+	res = <-results
+	assert.False(t, res.job.Passed)
+	assert.Equal(t, 1, res.attempts)
+	assert.Len(t, res.jobURLs, 1)
+}
+
+func TestCollectResultsInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var fetched []string
+	r := CloudRunner{
+		Ctx: ctx,
+		ArtifactDownloader: &mocks.FakeArifactDownloader{
+			DownloadArtifactFn: func(ctx context.Context, jobID string) error {
+				fetched = append(fetched, "artifacts")
+				return ctx.Err()
+			},
+		},
+		JobReader: &mocks.FakeJobReader{
+			GetJobAssetFileContentFn: func(ctx context.Context, jobID, fileName string) ([]byte, error) {
+				fetched = append(fetched, fileName)
+				return []byte("dummy-content"), ctx.Err()
+			},
+		},
+	}
+
+	results := make(chan result, 1)
+	results <- result{name: "dummy", job: job.Job{ID: "fake-id"}}
+	r.collectResults(config.ArtifactDownload{When: config.WhenAlways}, results, 1)
+
+	assert.Equal(t, []string{"artifacts", ConsoleLogAsset}, fetched)
+}
@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -52,6 +53,7 @@ func TestRunSuite(t *testing.T) {
 	}
 	runner := CypressRunner{
 		CloudRunner: CloudRunner{
+			Ctx:        context.Background(),
 			JobStarter: &starter,
 			JobReader:  &reader,
 			JobWriter:  &writer,
@@ -92,7 +94,8 @@ func TestRunSuites(t *testing.T) {
 		},
 	}
 	downloader := &mocks.FakeArifactDownloader{
-		DownloadArtifactFn: func(jobID string) {
+		DownloadArtifactFn: func(ctx context.Context, jobID string) error {
+			return nil
 		},
 	}
 	ccyReader := mocks.CCYReader{ReadAllowedCCYfn: func(ctx context.Context) (int, error) {
@@ -100,6 +103,7 @@ func TestRunSuites(t *testing.T) {
 	}}
 	runner := CypressRunner{
 		CloudRunner: CloudRunner{
+			Ctx:                context.Background(),
 			JobStarter:         &starter,
 			JobReader:          &reader,
 			JobWriter:          &writer,
@@ -144,10 +148,11 @@ func TestRunSuites_Cypress_NoConcurrency(t *testing.T) {
 		return 0, nil
 	}}
 	downloader := mocks.FakeArifactDownloader{
-		DownloadArtifactFn: func(jobID string) {},
+		DownloadArtifactFn: func(ctx context.Context, jobID string) error { return nil },
 	}
 	runner := CypressRunner{
 		CloudRunner: CloudRunner{
+			Ctx:                context.Background(),
 			JobStarter:         &starter,
 			JobReader:          &reader,
 			CCYReader:          ccyReader,
@@ -183,7 +188,7 @@ func TestArchiveProject(t *testing.T) {
 	wd, _ := os.Getwd()
 	log.Info().Msg(wd)
 
-	z, err := runner.archiveProject(runner.Project, "./test-arch/", runner.Project.RootDir, "")
+	z, err := runner.archiveProject(runner.Project, "./test-arch/", runner.Project.RootDir, config.SauceConfig{})
 	if err != nil {
 		t.Fail()
 	}
@@ -209,12 +214,57 @@ func TestArchiveProject(t *testing.T) {
 	assert.Equal(t, []byte("{}\n"), b)
 }
 
+func TestArchiveProject_Reproducible(t *testing.T) {
+	dir := t.TempDir()
+	project := cypress.Project{
+		RootDir: "../../tests/e2e/",
+		Sauce: config.SauceConfig{
+			Metadata: config.Metadata{Build: "build 1"},
+			Tunnel:   config.Tunnel{ID: "tunnel-1"},
+		},
+	}
+
+	archive := func(p cypress.Project, name string) []byte {
+		runner := CypressRunner{}
+		p.Sauce = bundledSauceConfig(p.Sauce)
+		tmp := filepath.Join(dir, name)
+		assert.NoError(t, os.Mkdir(tmp, 0755))
+		z, err := runner.archiveProject(p, tmp, p.RootDir, config.SauceConfig{})
+		assert.NoError(t, err)
+		b, err := os.ReadFile(z)
+		assert.NoError(t, err)
+		return b
+	}
+
+	first := archive(project, "first")
+	// The build name and the tunnel are passed to each job, rather than being part of the bundle.
+	project.Sauce.Metadata.Build = "build 2"
+	project.Sauce.Tunnel.ID = "tunnel-2"
+	assert.Equal(t, first, archive(project, "second"))
+}
+
+func TestArchiveProject_MaxBundleSize(t *testing.T) {
+	runner := CypressRunner{}
+	project := cypress.Project{RootDir: "../../tests/e2e/"}
+
+	_, err := runner.archiveProject(project, t.TempDir(), project.RootDir, config.SauceConfig{MaxBundleSize: "100MB"})
+	assert.NoError(t, err)
+
+	_, err = runner.archiveProject(project, t.TempDir(), project.RootDir, config.SauceConfig{MaxBundleSize: "10B"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds sauce.maxBundleSize of 10 B")
+
+	_, err = runner.archiveProject(project, t.TempDir(), project.RootDir, config.SauceConfig{MaxBundleSize: "lots"})
+	assert.EqualError(t, err, "invalid sauce.maxBundleSize: invalid size 'lots'")
+}
+
 func TestUploadProject(t *testing.T) {
 	uploader := &mocks.FakeProjectUploader{
 		UploadSuccess: true,
 	}
 	runner := CypressRunner{
 		CloudRunner: CloudRunner{
+			Ctx:             context.Background(),
 			ProjectUploader: uploader,
 		},
 	}
@@ -260,7 +310,7 @@ func TestRunProject(t *testing.T) {
 		},
 	}
 	downloader := mocks.FakeArifactDownloader{
-		DownloadArtifactFn: func(jobID string) {},
+		DownloadArtifactFn: func(ctx context.Context, jobID string) error { return nil },
 	}
 	ccyReader := mocks.CCYReader{ReadAllowedCCYfn: func(ctx context.Context) (int, error) {
 		return 1, nil
@@ -271,6 +321,7 @@ func TestRunProject(t *testing.T) {
 
 	runner := CypressRunner{
 		CloudRunner: CloudRunner{
+			Ctx:                context.Background(),
 			JobStarter:         &starter,
 			JobReader:          &reader,
 			JobWriter:          &writer,
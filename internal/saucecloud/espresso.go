@@ -3,7 +3,6 @@ package saucecloud
 import (
 	"fmt"
 	"strings"
-	"context"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -44,17 +43,17 @@ func (r *EspressoRunner) RunProject() (int, error) {
 		return 1, err
 	}
 
-	appFileID, err := r.uploadProject(r.Project.Espresso.App, appUpload)
+	appFileURI, err := r.uploadApp(r.Project.Espresso.App, appUpload)
 	if err != nil {
 		return exitCode, err
 	}
 
-	testAppFileID, err := r.uploadProject(r.Project.Espresso.TestApp, testAppUpload)
+	testAppFileURI, err := r.uploadApp(r.Project.Espresso.TestApp, testAppUpload)
 	if err != nil {
 		return exitCode, err
 	}
 
-	passed := r.runSuites(appFileID, testAppFileID)
+	passed := r.runSuites(appFileURI, testAppFileURI)
 	if passed {
 		exitCode = 0
 	}
@@ -62,10 +61,7 @@ func (r *EspressoRunner) RunProject() (int, error) {
 	return exitCode, nil
 }
 
-func (r *EspressoRunner) runSuites(appFileID string, testAppFileID string) bool {
-	sigChan := r.registerSkipSuitesOnSignal()
-	defer unregisterSignalCapture(sigChan)
-
+func (r *EspressoRunner) runSuites(appFileURI string, testAppFileURI string) bool {
 	jobOpts, results, err := r.createWorkerPool(r.Project.Sauce.Concurrency)
 	if err != nil {
 		return false
@@ -80,12 +76,12 @@ func (r *EspressoRunner) runSuites(appFileID string, testAppFileID string) bool
 				if(c.isRealDevice && strings.Contains(c.ID, ",")) {
 					// if , detected in device names, ping rdc for availability before launching.
 					log.Debug().Str("device", fmt.Sprintf("%v", c)).Msg("Looking for available device")
-					j, _ := r.RDCJobReader.PollDevicesState(context.Background(), c.ID, 15*time.Second)
+					j, _ := r.RDCJobReader.PollDevicesState(r.Ctx, c.ID, 15*time.Second)
 					c.ID = j
 				}
 
 				log.Debug().Str("suite", s.Name).Str("device", fmt.Sprintf("%v", c)).Msg("Starting job")
-				r.startJob(jobOpts, s, appFileID, testAppFileID, c)
+				r.startJob(jobOpts, s, appFileURI, testAppFileURI, c)
 			}
 		}
 		close(jobOpts)
@@ -134,7 +130,7 @@ func enumerateDevicesAndEmulators(devices []config.Device, emulators []config.Em
 }
 
 // startJob add the job to the list for the workers.
-func (r *EspressoRunner) startJob(jobOpts chan<- job.StartOptions, s espresso.Suite, appFileID, testAppFileID string, d deviceConfig) {
+func (r *EspressoRunner) startJob(jobOpts chan<- job.StartOptions, s espresso.Suite, appFileURI, testAppFileURI string, d deviceConfig) {
 	jto := job.TestOptions{
 		NotClass:   s.TestOptions.NotClass,
 		Class:      s.TestOptions.Class,
@@ -153,8 +149,8 @@ func (r *EspressoRunner) startJob(jobOpts chan<- job.StartOptions, s espresso.Su
 	jobOpts <- job.StartOptions{
 		DisplayName:       s.Name,
 		ConfigFilePath:    r.Project.ConfigFilePath,
-		App:               fmt.Sprintf("storage:%s", appFileID),
-		Suite:             fmt.Sprintf("storage:%s", testAppFileID),
+		App:               appFileURI,
+		Suite:             testAppFileURI,
 		Framework:         "espresso",
 		FrameworkVersion:  "1.0.0-stable",
 		PlatformName:      d.platformName,
@@ -171,6 +167,8 @@ func (r *EspressoRunner) startJob(jobOpts chan<- job.StartOptions, s espresso.Su
 		},
 		Experiments: r.Project.Sauce.Experiments,
 		TestOptions: jto,
+		Retries:     config.SuiteRetries(s.Retries),
+		Timeout:     s.Timeout,
 
 		// RDC Specific flags
 		RealDevice:        d.isRealDevice,
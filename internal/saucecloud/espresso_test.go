@@ -91,11 +91,12 @@ func TestEspressoRunner_RunProject(t *testing.T) {
 		UploadSuccess: true,
 	}
 	downloader := mocks.FakeArifactDownloader{
-		DownloadArtifactFn: func(jobID string) {},
+		DownloadArtifactFn: func(ctx context.Context, jobID string) error { return nil },
 	}
 
 	runner := &EspressoRunner{
 		CloudRunner: CloudRunner{
+			Ctx:                context.Background(),
 			JobStarter:         &starter,
 			JobReader:          &reader,
 			JobWriter:          &writer,
@@ -131,6 +132,64 @@ func TestEspressoRunner_RunProject(t *testing.T) {
 	assert.Equal(t, "landscape", startOpts.DeviceOrientation)
 }
 
+func TestEspressoRunner_RunProject_StorageReference(t *testing.T) {
+	var startOpts job.StartOptions
+	starter := mocks.FakeJobStarter{
+		StartJobFn: func(ctx context.Context, opts job.StartOptions) (jobID string, isRDC bool, err error) {
+			startOpts = opts
+			return "fake-job-id", false, nil
+		},
+	}
+	reader := mocks.FakeJobReader{
+		PollJobFn: func(ctx context.Context, id string, interval time.Duration) (job.Job, error) {
+			return job.Job{ID: id, Passed: true}, nil
+		},
+	}
+	ccyReader := mocks.CCYReader{ReadAllowedCCYfn: func(ctx context.Context) (int, error) {
+		return 1, nil
+	}}
+	downloader := mocks.FakeArifactDownloader{
+		DownloadArtifactFn: func(ctx context.Context, jobID string) error { return nil },
+	}
+
+	runner := &EspressoRunner{
+		CloudRunner: CloudRunner{
+			Ctx:        context.Background(),
+			JobStarter: &starter,
+			JobReader:  &reader,
+			CCYReader:  ccyReader,
+			// Any upload attempt fails the run.
+			ProjectUploader:    &mocks.FakeProjectUploader{UploadSuccess: false},
+			ArtifactDownloader: &downloader,
+		},
+		Project: espresso.Project{
+			Espresso: espresso.Espresso{
+				App:     "storage:my-app-id",
+				TestApp: "storage:filename=testApp.apk",
+			},
+			Suites: []espresso.Suite{
+				{
+					Name: "my espresso project",
+					Emulators: []config.Emulator{
+						{
+							Name:             "Android GoogleApi Emulator",
+							PlatformVersions: []string{"11.0"},
+						},
+					},
+				},
+			},
+			Sauce: config.SauceConfig{
+				Concurrency: 1,
+			},
+		},
+	}
+	cnt, err := runner.RunProject()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, cnt)
+	assert.Equal(t, "storage:my-app-id", startOpts.App)
+	assert.Equal(t, "storage:filename=testApp.apk", startOpts.Suite)
+}
+
 func TestRunSuites_Espresso_NoConcurrency(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -151,6 +210,7 @@ func TestRunSuites_Espresso_NoConcurrency(t *testing.T) {
 	}}
 	runner := EspressoRunner{
 		CloudRunner: CloudRunner{
+			Ctx:        context.Background(),
 			JobStarter: &starter,
 			JobReader:  &reader,
 			CCYReader:  ccyReader,
@@ -47,6 +47,7 @@ func TestRunSuites_Playwright_NoConcurrency(t *testing.T) {
 	}}
 	runner := PlaywrightRunner{
 		CloudRunner: CloudRunner{
+			Ctx:        context.Background(),
 			JobStarter: &starter,
 			JobReader:  &reader,
 			CCYReader:  ccyReader,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/job"
 	"github.com/saucelabs/saucectl/internal/testcafe"
 )
@@ -22,14 +23,17 @@ func (r *TestcafeRunner) RunProject() (int, error) {
 		return 1, err
 	}
 
+	rc := r.Project
+	rc.Sauce = bundledSauceConfig(rc.Sauce)
+
 	if r.DryRun {
-		if err := r.dryRun(r.Project, r.Project.RootDir, r.Project.Sauce.Sauceignore, r.getSuiteNames()); err != nil {
+		if err := r.dryRun(rc, r.Project.RootDir, r.Project.Sauce, r.getSuiteNames()); err != nil {
 			return exitCode, err
 		}
 		return 0, nil
 	}
 
-	fileID, err := r.archiveAndUpload(r.Project, r.Project.RootDir, r.Project.Sauce.Sauceignore)
+	fileID, err := r.archiveAndUpload(rc, r.Project.RootDir, r.Project.Sauce)
 	if err != nil {
 		return exitCode, err
 	}
@@ -51,9 +55,6 @@ func (r *TestcafeRunner) getSuiteNames() string {
 }
 
 func (r *TestcafeRunner) runSuites(fileID string) bool {
-	sigChan := r.registerSkipSuitesOnSignal()
-	defer unregisterSignalCapture(sigChan)
-
 	jobOpts, results, err := r.createWorkerPool(r.Project.Sauce.Concurrency)
 	if err != nil {
 		return false
@@ -89,6 +90,8 @@ func (r *TestcafeRunner) runSuites(fileID string) bool {
 							ScreenResolution: s.ScreenResolution,
 							RunnerVersion:    r.Project.RunnerVersion,
 							Experiments:      r.Project.Sauce.Experiments,
+							Retries:          config.SuiteRetries(s.Retries),
+							Timeout:          s.Timeout,
 						}
 					}
 				}
@@ -113,6 +116,8 @@ func (r *TestcafeRunner) runSuites(fileID string) bool {
 					ScreenResolution: s.ScreenResolution,
 					RunnerVersion:    r.Project.RunnerVersion,
 					Experiments:      r.Project.Sauce.Experiments,
+					Retries:          config.SuiteRetries(s.Retries),
+					Timeout:          s.Timeout,
 				}
 			}
 		}
@@ -49,6 +49,7 @@ func TestRunSuites_TestCafe_NoConcurrency(t *testing.T) {
 	}}
 	runner := PlaywrightRunner{
 		CloudRunner: CloudRunner{
+			Ctx:        context.Background(),
 			JobStarter: &starter,
 			JobReader:  &reader,
 			CCYReader:  ccyReader,
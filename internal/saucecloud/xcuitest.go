@@ -13,6 +13,7 @@ import (
 	"github.com/saucelabs/saucectl/internal/config"
 	"github.com/saucelabs/saucectl/internal/job"
 	"github.com/saucelabs/saucectl/internal/sauceignore"
+	"github.com/saucelabs/saucectl/internal/storage"
 	"github.com/saucelabs/saucectl/internal/xcuitest"
 )
 
@@ -40,17 +41,17 @@ func (r *XcuitestRunner) RunProject() (int, error) {
 		return exitCode, err
 	}
 
-	appFileID, err := r.uploadProject(appPath, appUpload)
+	appFileURI, err := r.uploadApp(appPath, appUpload)
 	if err != nil {
 		return exitCode, err
 	}
 
-	testAppFileID, err := r.uploadProject(testAppPath, testAppUpload)
+	testAppFileURI, err := r.uploadApp(testAppPath, testAppUpload)
 	if err != nil {
 		return exitCode, err
 	}
 
-	passed := r.runSuites(appFileID, testAppFileID)
+	passed := r.runSuites(appFileURI, testAppFileURI)
 	if passed {
 		exitCode = 0
 	}
@@ -67,10 +68,7 @@ func (r *XcuitestRunner) dryRun() {
 	}
 }
 
-func (r *XcuitestRunner) runSuites(appFileID, testAppFileID string) bool {
-	sigChan := r.registerSkipSuitesOnSignal()
-	defer unregisterSignalCapture(sigChan)
-
+func (r *XcuitestRunner) runSuites(appFileURI, testAppFileURI string) bool {
 	jobOpts, results, err := r.createWorkerPool(r.Project.Sauce.Concurrency)
 	if err != nil {
 		return false
@@ -83,7 +81,7 @@ func (r *XcuitestRunner) runSuites(appFileID, testAppFileID string) bool {
 		for _, s := range r.Project.Suites {
 			for _, d := range s.Devices {
 				log.Debug().Str("suite", s.Name).Str("deviceName", d.Name).Str("deviceID", d.ID).Str("platformVersion", d.PlatformVersion).Msg("Starting job")
-				r.startJob(jobOpts, appFileID, testAppFileID, s, d)
+				r.startJob(jobOpts, appFileURI, testAppFileURI, s, d)
 			}
 		}
 		close(jobOpts)
@@ -92,12 +90,12 @@ func (r *XcuitestRunner) runSuites(appFileID, testAppFileID string) bool {
 	return r.collectResults(r.Project.Artifacts.Download, results, jobsCount)
 }
 
-func (r *XcuitestRunner) startJob(jobOpts chan<- job.StartOptions, appFileID, testAppFileID string, s xcuitest.Suite, d config.Device) {
+func (r *XcuitestRunner) startJob(jobOpts chan<- job.StartOptions, appFileURI, testAppFileURI string, s xcuitest.Suite, d config.Device) {
 	jobOpts <- job.StartOptions{
 		ConfigFilePath:   r.Project.ConfigFilePath,
 		DisplayName:      s.Name,
-		App:              fmt.Sprintf("storage:%s", appFileID),
-		Suite:            fmt.Sprintf("storage:%s", testAppFileID),
+		App:              appFileURI,
+		Suite:            testAppFileURI,
 		Framework:        "xcuitest",
 		FrameworkVersion: "1.0.0-stable",
 		PlatformName:     d.PlatformName,
@@ -113,6 +111,8 @@ func (r *XcuitestRunner) startJob(jobOpts chan<- job.StartOptions, appFileID, te
 		},
 		Experiments: r.Project.Sauce.Experiments,
 		TestsToRun:  s.TestOptions.Class,
+		Retries:     config.SuiteRetries(s.Retries),
+		Timeout:     s.Timeout,
 
 		// RDC Specific flags
 		RealDevice:        true,
@@ -130,12 +130,12 @@ func (r *XcuitestRunner) calculateJobsCount(suites []xcuitest.Suite) int {
 	return jobsCount
 }
 
-// archiveAppsToIpaIfRequired checks if apps are a .ipa package. Otherwise, it generates one.
+// archiveAppsToIpaIfRequired checks if apps are a .ipa package or a storage reference. Otherwise, it generates one.
 func archiveAppsToIpaIfRequired(appPath, testAppPath string) (archivedAppPath string, archivedTestAppPath string, archivedErr error) {
 	archivedAppPath = appPath
 	archivedTestAppPath = testAppPath
 	var err error
-	if !strings.HasSuffix(appPath, ".ipa") {
+	if !storage.IsReference(appPath) && !strings.HasSuffix(appPath, ".ipa") {
 		archivedAppPath, err = archiveAppToIpa(appPath)
 		if err != nil {
 			log.Error().Msgf("Unable to archive %s to ipa: %v", appPath, err)
@@ -143,11 +143,11 @@ func archiveAppsToIpaIfRequired(appPath, testAppPath string) (archivedAppPath st
 			return
 		}
 	}
-	if !strings.HasSuffix(testAppPath, ".ipa") {
+	if !storage.IsReference(testAppPath) && !strings.HasSuffix(testAppPath, ".ipa") {
 		archivedTestAppPath, err = archiveAppToIpa(testAppPath)
 		if err != nil {
 			log.Error().Msgf("Unable to archive %s to ipa: %v", testAppPath, err)
-			fmt.Errorf("unable to archive %s", testAppPath)
+			archivedErr = fmt.Errorf("unable to archive %s", testAppPath)
 			return
 		}
 	}
@@ -162,7 +162,7 @@ func archiveAppToIpa(appPath string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	arch, _ := zip.New(tmpFile, sauceignore.NewMatcher([]sauceignore.Pattern{}))
+	arch, _ := zip.New(tmpFile, sauceignore.NewMatcher([]sauceignore.Pattern{}), zip.Options{})
 	defer arch.Close()
 	err = arch.Add(appPath, "Payload/")
 	if err != nil {
@@ -58,11 +58,12 @@ func TestXcuitestRunner_RunProject(t *testing.T) {
 		UploadSuccess: true,
 	}
 	downloader := mocks.FakeArifactDownloader{
-		DownloadArtifactFn: func(jobID string) {},
+		DownloadArtifactFn: func(ctx context.Context, jobID string) error { return nil },
 	}
 
 	runner := &XcuitestRunner{
 		CloudRunner: CloudRunner{
+			Ctx:                context.Background(),
 			JobStarter:         &starter,
 			JobReader:          &reader,
 			JobWriter:          &writer,
@@ -120,6 +121,7 @@ func TestRunSuites_Xcuitest_NoConcurrency(t *testing.T) {
 	}}
 	runner := XcuitestRunner{
 		CloudRunner: CloudRunner{
+			Ctx:        context.Background(),
 			JobStarter: &starter,
 			JobReader:  &reader,
 			CCYReader:  ccyReader,
@@ -199,6 +201,14 @@ func TestXcuitestRunner_ensureAppsAreIpa(t *testing.T) {
 	checkFileFound(t, testAppPath, "Payload/my-test-app.app/test-check-me.txt", "test-check-me")
 }
 
+func TestXcuitestRunner_ensureAppsAreIpa_StorageReference(t *testing.T) {
+	appPath, testAppPath, err := archiveAppsToIpaIfRequired("storage:my-app-id", "storage:filename=my-test-app.ipa")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "storage:my-app-id", appPath)
+	assert.Equal(t, "storage:filename=my-test-app.ipa", testAppPath)
+}
+
 func checkFileFound(t *testing.T, archiveName, fileName, fileContent string) {
 	rd, _ := zip.OpenReader(archiveName)
 	defer rd.Close()
@@ -0,0 +1,121 @@
+package sauceignore
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Names of the ignore files that are looked up in every directory of a project.
+const (
+	SauceignoreFile = ".sauceignore"
+	GitignoreFile   = ".gitignore"
+)
+
+// DefaultPatterns are excluded from every project, unless an ignore file includes them again, e.g. via "!.git/".
+var DefaultPatterns = []string{".git/", ".hg/", ".svn/", ".DS_Store"}
+
+// Options configures which ignore files make up the patterns of a project.
+type Options struct {
+	// UseGitignore applies .gitignore files as well. Patterns of a .sauceignore file take precedence over those of a
+	// .gitignore file in the same directory.
+	UseGitignore bool
+}
+
+// PatternsFromDir returns the patterns that apply to the project in root: DefaultPatterns, followed by the patterns of
+// the .gitignore file in root if opts.UseGitignore is set, followed by the patterns of the .sauceignore file at path,
+// followed by the patterns of the ignore files found in the subdirectories of root. The file at path takes the place
+// of the .sauceignore file in root, which isn't read if path points elsewhere. The patterns of nested ignore files
+// are scoped to the directory they are in, just like nested .gitignore files. Directories that are excluded are not
+// searched.
+func PatternsFromDir(root, path string, opts Options) ([]Pattern, error) {
+	var ps []Pattern
+	for _, p := range DefaultPatterns {
+		ps = append(ps, NewPattern(p))
+	}
+
+	names := []string{SauceignoreFile}
+	if opts.UseGitignore {
+		names = []string{GitignoreFile, SauceignoreFile}
+
+		gps, err := nestedPatterns(filepath.Join(root, GitignoreFile), splitPath(root))
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, gps...)
+	}
+
+	fps, err := PatternsFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ps = append(ps, fps...)
+
+	m := NewMatcher(ps)
+	err = filepath.WalkDir(root, func(dir string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// The ignore files of root have been read already.
+		if !d.IsDir() || dir == root {
+			return nil
+		}
+		domain := splitPath(dir)
+		if m.Match(domain, true) {
+			return filepath.SkipDir
+		}
+
+		found := false
+		for _, name := range names {
+			file := filepath.Join(dir, name)
+			if filepath.Clean(file) == filepath.Clean(path) {
+				continue
+			}
+			nps, err := nestedPatterns(file, domain)
+			if err != nil {
+				return err
+			}
+			ps = append(ps, nps...)
+			found = found || len(nps) > 0
+		}
+		if found {
+			m = NewMatcher(ps)
+		}
+		return nil
+	})
+
+	return ps, err
+}
+
+// NewMatcherFromDir constructs a new matcher from the patterns that apply to the project in root. See PatternsFromDir.
+func NewMatcherFromDir(root, path string, opts Options) (Matcher, error) {
+	ps, err := PatternsFromDir(root, path, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMatcher(ps), nil
+}
+
+// nestedPatterns reads the patterns of the ignore file at path, if it exists, and scopes them to domain.
+func nestedPatterns(path string, domain []string) ([]Pattern, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	ps, err := PatternsFromFile(path)
+	for i := range ps {
+		ps[i].Domain = domain
+	}
+	return ps, err
+}
+
+// splitPath splits path into its elements, the same way that archives split paths before matching them.
+func splitPath(path string) []string {
+	path = filepath.Clean(path)
+	if path == "." {
+		return nil
+	}
+	return strings.Split(path, string(os.PathSeparator))
+}
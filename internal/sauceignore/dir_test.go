@@ -0,0 +1,116 @@
+package sauceignore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestNewMatcherFromDir(t *testing.T) {
+	dir := fs.NewDir(t, "project",
+		fs.WithFile(".sauceignore", "*.log\n"),
+		fs.WithFile(".gitignore", "dist/\n"),
+		fs.WithDir(".git", fs.WithFile("config", "")),
+		fs.WithDir("packages",
+			fs.WithDir("a",
+				fs.WithFile(".sauceignore", "fixtures/\n!keep.log\n"),
+				fs.WithFile(".gitignore", "*.tmp\n"),
+				fs.WithDir("fixtures"),
+			),
+			fs.WithDir("b",
+				fs.WithFile(".sauceignore", "!.git/\n"),
+			),
+		),
+		fs.WithDir("node_modules",
+			fs.WithFile(".sauceignore", "unreachable\n"),
+		),
+	)
+	defer dir.Remove()
+
+	wd, err := os.Getwd()
+	assert.NilError(t, err)
+	assert.NilError(t, os.Chdir(dir.Path()))
+	defer os.Chdir(wd)
+
+	testCases := []struct {
+		name         string
+		opts         Options
+		path         string
+		isDir        bool
+		wantExcluded bool
+	}{
+		{name: "root pattern", path: "packages/a/debug.log", wantExcluded: true},
+		{name: "nested negation", path: "packages/a/keep.log"},
+		{name: "nested pattern", path: "packages/a/fixtures", isDir: true, wantExcluded: true},
+		{name: "nested pattern is scoped", path: "packages/b/fixtures", isDir: true},
+		{name: "default pattern", path: ".git", isDir: true, wantExcluded: true},
+		{name: "default pattern overridden", path: "packages/b/.git", isDir: true},
+		{name: "gitignore is not used by default", path: "dist", isDir: true},
+		{name: "gitignore", opts: Options{UseGitignore: true}, path: "dist", isDir: true, wantExcluded: true},
+		{name: "nested gitignore", opts: Options{UseGitignore: true}, path: "packages/a/x.tmp", wantExcluded: true},
+		{name: "nested gitignore is scoped", opts: Options{UseGitignore: true}, path: "packages/b/x.tmp"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMatcherFromDir(".", ".sauceignore", tc.opts)
+			assert.NilError(t, err)
+			assert.Equal(t, m.Match(strings.Split(tc.path, "/"), tc.isDir), tc.wantExcluded)
+		})
+	}
+}
+
+func TestNewMatcherFromDir_SauceignoreOverridesGitignore(t *testing.T) {
+	dir := fs.NewDir(t, "project",
+		fs.WithFile(".gitignore", "dist/\nbuild/\n"),
+		fs.WithFile(".sauceignore", "!dist/\n"),
+		fs.WithDir("dist"),
+		fs.WithDir("build"),
+	)
+	defer dir.Remove()
+
+	m, err := NewMatcherFromDir(dir.Path(), dir.Join(".sauceignore"), Options{UseGitignore: true})
+	assert.NilError(t, err)
+	assert.Equal(t, m.Match(strings.Split(dir.Join("dist"), string(os.PathSeparator)), true), false)
+	assert.Equal(t, m.Match(strings.Split(dir.Join("build"), string(os.PathSeparator)), true), true)
+}
+
+func TestPatternsFromDir_CustomSauceignore(t *testing.T) {
+	dir := fs.NewDir(t, "project",
+		fs.WithFile(".sauceignore", "root/\n"),
+		fs.WithDir(".sauce", fs.WithFile("custom.sauceignore", "custom/\n")),
+	)
+	defer dir.Remove()
+
+	ps, err := PatternsFromDir(dir.Path(), dir.Join(".sauce", "custom.sauceignore"), Options{})
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, ps[len(DefaultPatterns):], []Pattern{
+		{P: "custom/", File: dir.Join(".sauce", "custom.sauceignore"), Line: 1},
+	})
+}
+
+func TestPatternsFromDir(t *testing.T) {
+	dir := fs.NewDir(t, "project",
+		fs.WithFile(".sauceignore", "node_modules/\n"),
+		fs.WithDir("tests",
+			fs.WithFile(".sauceignore", "# videos\nvideos/\n"),
+		),
+		fs.WithDir("node_modules",
+			fs.WithFile(".sauceignore", "unreachable\n"),
+		),
+	)
+	defer dir.Remove()
+
+	ps, err := PatternsFromDir(dir.Path(), dir.Join(".sauceignore"), Options{})
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, ps[len(DefaultPatterns):], []Pattern{
+		{P: "node_modules/", File: dir.Join(".sauceignore"), Line: 1},
+		{P: "videos/", File: dir.Join("tests", ".sauceignore"), Line: 2,
+			Domain: strings.Split(filepath.Join(dir.Path(), "tests"), string(os.PathSeparator))},
+	})
+}
@@ -12,8 +12,8 @@ import (
 
 const commentPrefix = "#"
 
-// patternsFromFile reads .sauceignore file and creates ignore patters if .sauceignore file is exists.
-func patternsFromFile(path string) ([]Pattern, error) {
+// PatternsFromFile reads .sauceignore file and creates ignore patters if .sauceignore file is exists.
+func PatternsFromFile(path string) ([]Pattern, error) {
 	fPath := filepath.Join(path)
 	f, err := os.Open(fPath)
 	if err != nil {
@@ -28,10 +28,13 @@ func patternsFromFile(path string) ([]Pattern, error) {
 
 	var ps []Pattern
 	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
+	for line := 1; scanner.Scan(); line++ {
 		s := scanner.Text()
 		if !strings.HasPrefix(s, commentPrefix) && len(strings.TrimSpace(s)) > 0 {
-			ps = append(ps, NewPattern(s))
+			p := NewPattern(s)
+			p.File = path
+			p.Line = line
+			ps = append(ps, p)
 		}
 	}
 
@@ -41,6 +44,12 @@ func patternsFromFile(path string) ([]Pattern, error) {
 // Pattern defines a single sauceignore pattern.
 type Pattern struct {
 	P string
+	// File is the ignore file that defines the pattern. Built-in patterns have none.
+	File string
+	// Line is the line of the .sauceignore file that defines the pattern, if any.
+	Line int
+	// Domain is the directory that the pattern is scoped to, i.e. the directory of a nested ignore file.
+	Domain []string
 }
 
 // NewPattern create new Pattern.
@@ -51,7 +60,7 @@ func NewPattern(p string) Pattern {
 func convPtrnsToGitignorePtrns(pp []Pattern) []gitignore.Pattern {
 	res := make([]gitignore.Pattern, len(pp))
 	for i := 0; i < len(pp); i++ {
-		res[i] = gitignore.ParsePattern(pp[i].P, nil)
+		res[i] = gitignore.ParsePattern(pp[i].P, pp[i].Domain)
 	}
 
 	return res
@@ -79,10 +88,24 @@ func NewMatcher(ps []Pattern) Matcher {
 
 // NewMatcherFromFile constructs a new matcher from file.
 func NewMatcherFromFile(path string) (Matcher, error) {
-	ps, err := patternsFromFile(path)
+	ps, err := PatternsFromFile(path)
 	if err != nil {
 		return nil, err
 	}
 
 	return NewMatcher(ps), nil
 }
+
+// Explain returns the pattern that decides whether path is excluded, i.e. the last of ps that matches it, and whether
+// that pattern excludes the path. ok is false if none of ps match.
+func Explain(ps []Pattern, path []string, isDir bool) (p Pattern, excluded bool, ok bool) {
+	for i := len(ps) - 1; i >= 0; i-- {
+		switch gitignore.ParsePattern(ps[i].P, ps[i].Domain).Match(path, isDir) {
+		case gitignore.Exclude:
+			return ps[i], true, true
+		case gitignore.Include:
+			return ps[i], false, true
+		}
+	}
+	return Pattern{}, false, false
+}
@@ -48,7 +48,7 @@ func TestPatternsFromFile(t *testing.T) {
 
 	for _, tc := range testsCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gotPatters, err := patternsFromFile(tc.path)
+			gotPatters, err := PatternsFromFile(tc.path)
 			assert.Equal(t, err, tc.expectedErr)
 			assert.Equal(t, len(gotPatters), len(tc.expectedPatters))
 		})
@@ -140,6 +140,50 @@ func TestSauceMatcher(t *testing.T) {
 	}
 }
 
+func TestPatternsFromFile_Lines(t *testing.T) {
+	fn, file, err := crtTempSauceignoreFile()
+	if err != nil {
+		t.Fatalf("couldn't create temp .sauceignore file %s", err)
+	}
+	defer fn()
+
+	ps, err := PatternsFromFile(file)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ps[2], Pattern{P: "node_modules/", File: file, Line: 4})
+	assert.DeepEqual(t, ps[5], Pattern{P: ".DS_Store", File: file, Line: 8})
+}
+
+func TestExplain(t *testing.T) {
+	patterns := []Pattern{
+		{P: "*.log", Line: 1},
+		{P: "!important.log", Line: 2},
+		{P: "cypress/videos/", Line: 3},
+	}
+
+	testCases := []struct {
+		name         string
+		path         []string
+		isDir        bool
+		wantPattern  Pattern
+		wantExcluded bool
+		wantOK       bool
+	}{
+		{name: "no pattern matches", path: []string{"index.js"}},
+		{name: "excluded", path: []string{"logs", "cron.log"}, wantPattern: patterns[0], wantExcluded: true, wantOK: true},
+		{name: "negated", path: []string{"logs", "important.log"}, wantPattern: patterns[1], wantExcluded: false, wantOK: true},
+		{name: "directory", path: []string{"cypress", "videos"}, isDir: true, wantPattern: patterns[2], wantExcluded: true, wantOK: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, excluded, ok := Explain(patterns, tc.path, tc.isDir)
+			assert.DeepEqual(t, p, tc.wantPattern)
+			assert.Equal(t, excluded, tc.wantExcluded)
+			assert.Equal(t, ok, tc.wantOK)
+		})
+	}
+}
+
 func crtTempSauceignoreFile() (func(), string, error) {
 	content := `cypress/screenshots/
 cypress/videos/
@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Draft is the JSON Schema dialect of every generated schema.
+const Draft = "http://json-schema.org/draft-07/schema#"
+
+// Types of values, as defined by JSON Schema.
+const (
+	Object  = "object"
+	Array   = "array"
+	String  = "string"
+	Integer = "integer"
+	Number  = "number"
+	Boolean = "boolean"
+)
+
+// Schema represents a (subset of a) JSON Schema, which describes the structure of a config file.
+type Schema struct {
+	Draft       string             `json:"$schema,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	Enum        []string           `json:"enum,omitempty"`
+
+	// AdditionalProperties is either false, which forbids any keys that are not declared in Properties, or a *Schema
+	// that every value of an undeclared key must satisfy.
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+}
+
+// Generate returns the schema of v, which is derived from the yaml tags of its fields. The values that a string field
+// accepts can be restricted by an additional `enum:"a,b"` tag.
+func Generate(v interface{}) *Schema {
+	s := generate(reflect.TypeOf(v))
+	s.Draft = Draft
+	return s
+}
+
+// durationType is decoded from strings like "10m", rather than from integers like other int64 types.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func generate(t reflect.Type) *Schema {
+	if t == durationType {
+		return &Schema{Type: String}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return generate(t.Elem())
+	case reflect.Struct:
+		s := &Schema{Type: Object, Properties: map[string]*Schema{}, AdditionalProperties: false}
+		addFields(s, t)
+		return s
+	case reflect.Map:
+		return &Schema{Type: Object, AdditionalProperties: generate(t.Elem())}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: Array, Items: generate(t.Elem())}
+	case reflect.String:
+		return &Schema{Type: String}
+	case reflect.Bool:
+		return &Schema{Type: Boolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: Integer}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Number}
+	default:
+		// Anything goes.
+		return &Schema{}
+	}
+}
+
+// addFields adds the properties of struct t to s, following the same rules that the yaml decoder applies.
+func addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue // unexported
+		}
+
+		tag := f.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+
+		inline := false
+		for _, o := range opts[1:] {
+			if o == "inline" {
+				inline = true
+			}
+		}
+		if inline {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			addFields(s, ft)
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		fs := generate(f.Type)
+		if enum := f.Tag.Get("enum"); enum != "" {
+			fs.Enum = strings.Split(enum, ",")
+		}
+		s.Properties[name] = fs
+	}
+}
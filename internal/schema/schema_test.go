@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type inner struct {
+	Flag *bool `yaml:"flag,omitempty"`
+}
+
+type sample struct {
+	Embedded `yaml:",inline"`
+	Untagged bool
+	Ignored  string            `yaml:"-"`
+	Name     string            `yaml:"name,omitempty"`
+	Mode     string            `yaml:"mode,omitempty" enum:"docker,sauce"`
+	Count    int               `yaml:"count"`
+	Speed    float64           `yaml:"speed"`
+	Timeout  time.Duration     `yaml:"timeout"`
+	Tags     []string          `yaml:"tags"`
+	Env      map[string]string `yaml:"env"`
+	Inner    inner             `yaml:"inner"`
+}
+
+type Embedded struct {
+	Kind string `yaml:"kind"`
+}
+
+func TestGenerate(t *testing.T) {
+	s := Generate(sample{})
+
+	assert.Equal(t, Draft, s.Draft)
+	assert.Equal(t, Object, s.Type)
+	assert.Equal(t, false, s.AdditionalProperties)
+
+	var keys []string
+	for k := range s.Properties {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(t, []string{"kind", "untagged", "name", "mode", "count", "speed", "timeout", "tags", "env", "inner"}, keys)
+
+	assert.Equal(t, &Schema{Type: String, Enum: []string{"docker", "sauce"}}, s.Properties["mode"])
+	assert.Equal(t, &Schema{Type: Integer}, s.Properties["count"])
+	assert.Equal(t, &Schema{Type: Number}, s.Properties["speed"])
+	assert.Equal(t, &Schema{Type: String}, s.Properties["timeout"])
+	assert.Equal(t, &Schema{Type: Array, Items: &Schema{Type: String}}, s.Properties["tags"])
+	assert.Equal(t, &Schema{Type: Object, AdditionalProperties: &Schema{Type: String}}, s.Properties["env"])
+	assert.Equal(t, &Schema{Type: Boolean}, s.Properties["inner"].Properties["flag"])
+}
@@ -0,0 +1,179 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Problem describes a violation of the schema at a specific location of a yaml document.
+type Problem struct {
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+func (p Problem) String() string {
+	if p.Path == "" {
+		return p.Message
+	}
+	return fmt.Sprintf("%s: %s", p.Path, p.Message)
+}
+
+// Validate checks node against the schema s and returns every problem it finds, ordered by their position in the
+// document.
+func Validate(node *yaml.Node, s *Schema) []Problem {
+	var pp []Problem
+	validate(node, s, "", &pp)
+
+	Sort(pp)
+	return pp
+}
+
+// Sort orders pp by their position in the document.
+func Sort(pp []Problem) {
+	sort.SliceStable(pp, func(i, j int) bool {
+		if pp[i].Line == pp[j].Line {
+			return pp[i].Column < pp[j].Column
+		}
+		return pp[i].Line < pp[j].Line
+	})
+}
+
+func validate(n *yaml.Node, s *Schema, path string, pp *[]Problem) {
+	if n == nil || s == nil {
+		return
+	}
+
+	switch n.Kind {
+	case yaml.DocumentNode:
+		for _, c := range n.Content {
+			validate(c, s, path, pp)
+		}
+		return
+	case yaml.AliasNode:
+		validate(n.Alias, s, path, pp)
+		return
+	}
+
+	// A null value is treated the same as a missing key.
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return
+	}
+
+	add := func(n *yaml.Node, path, format string, args ...interface{}) {
+		*pp = append(*pp, Problem{Line: n.Line, Column: n.Column, Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch s.Type {
+	case Object:
+		if n.Kind != yaml.MappingNode {
+			add(n, path, "expected an object, but got %s", describe(n))
+			return
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			if k.Value == "<<" {
+				// Merge keys are resolved by the yaml decoder.
+				continue
+			}
+			kp := join(path, k.Value)
+			if ps, ok := s.Properties[k.Value]; ok {
+				validate(v, ps, kp, pp)
+				continue
+			}
+			if as, ok := s.AdditionalProperties.(*Schema); ok {
+				validate(v, as, kp, pp)
+				continue
+			}
+			if s.AdditionalProperties == false {
+				add(k, kp, "unknown field %q%s", k.Value, suggest(k.Value, s.Properties))
+			}
+		}
+	case Array:
+		if n.Kind != yaml.SequenceNode {
+			add(n, path, "expected a list, but got %s", describe(n))
+			return
+		}
+		for i, c := range n.Content {
+			validate(c, s.Items, fmt.Sprintf("%s[%d]", path, i), pp)
+		}
+	case String, Integer, Number, Boolean:
+		if n.Kind != yaml.ScalarNode || !isScalarOf(n, s.Type) {
+			add(n, path, "expected %s %s, but got %s", article(s.Type), s.Type, describe(n))
+			return
+		}
+		if len(s.Enum) > 0 && !contains(s.Enum, n.Value) {
+			add(n, path, "illegal value %q, must be one of '%s'", n.Value, strings.Join(s.Enum, "|"))
+		}
+	}
+}
+
+// isScalarOf returns true if the scalar n can be decoded into a value of type t.
+func isScalarOf(n *yaml.Node, t string) bool {
+	switch t {
+	case String:
+		// The decoder accepts any scalar for a string.
+		return true
+	case Integer:
+		return n.Tag == "!!int"
+	case Number:
+		return n.Tag == "!!int" || n.Tag == "!!float"
+	case Boolean:
+		if n.Tag == "!!bool" {
+			return true
+		}
+		// YAML 1.1 booleans, which are still accepted by the decoder.
+		switch strings.ToLower(n.Value) {
+		case "yes", "no", "on", "off", "y", "n":
+			return true
+		}
+	}
+	return false
+}
+
+func describe(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "an object"
+	case yaml.SequenceNode:
+		return "a list"
+	}
+	return fmt.Sprintf("%q", n.Value)
+}
+
+func article(t string) string {
+	if strings.IndexAny(t[:1], "aeiou") == 0 {
+		return "an"
+	}
+	return "a"
+}
+
+// suggest returns a hint to a declared property that only differs from key by case, which is a common mistake.
+func suggest(key string, properties map[string]*Schema) string {
+	for p := range properties {
+		if strings.EqualFold(p, key) {
+			return fmt.Sprintf(", did you mean %q?", p)
+		}
+	}
+	return ""
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want []Problem
+	}{
+		{
+			name: "valid",
+			doc: `kind: cypress
+name: test
+mode: docker
+count: 2
+speed: 1
+tags: [a, b]
+env:
+  FOO: bar
+inner:
+  flag: yes
+`,
+		},
+		{
+			name: "null values",
+			doc: `name:
+inner: ~
+`,
+		},
+		{
+			name: "unknown keys",
+			doc: `naem: test
+inner:
+  Flag: true
+`,
+			want: []Problem{
+				{Line: 1, Column: 1, Path: "naem", Message: `unknown field "naem"`},
+				{Line: 3, Column: 3, Path: "inner.Flag", Message: `unknown field "Flag", did you mean "flag"?`},
+			},
+		},
+		{
+			name: "wrong types",
+			doc: `count: two
+speed: fast
+tags: a
+env:
+  - FOO
+inner:
+  flag: 1
+`,
+			want: []Problem{
+				{Line: 1, Column: 8, Path: "count", Message: `expected an integer, but got "two"`},
+				{Line: 2, Column: 8, Path: "speed", Message: `expected a number, but got "fast"`},
+				{Line: 3, Column: 7, Path: "tags", Message: `expected a list, but got "a"`},
+				{Line: 5, Column: 3, Path: "env", Message: `expected an object, but got a list`},
+				{Line: 7, Column: 9, Path: "inner.flag", Message: `expected a boolean, but got "1"`},
+			},
+		},
+		{
+			name: "illegal enum value",
+			doc: `mode: dockr
+`,
+			want: []Problem{
+				{Line: 1, Column: 7, Path: "mode", Message: `illegal value "dockr", must be one of 'docker|sauce'`},
+			},
+		},
+		{
+			name: "list items",
+			doc: `tags:
+  - a
+  - [b]
+`,
+			want: []Problem{
+				{Line: 3, Column: 5, Path: "tags[1]", Message: `expected a string, but got a list`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n yaml.Node
+			if err := yaml.Unmarshal([]byte(tt.doc), &n); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.want, Validate(&n, Generate(sample{})))
+		})
+	}
+}
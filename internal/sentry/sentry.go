@@ -6,6 +6,7 @@ import (
 	"github.com/getsentry/sentry-go"
 	"github.com/rs/zerolog/log"
 	"github.com/saucelabs/saucectl/cli/setup"
+	"github.com/saucelabs/saucectl/internal/requesth"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -31,7 +32,7 @@ func CaptureError(err error, scope Scope) {
 	sentry.ConfigureScope(func(sentryScope *sentry.Scope) {
 		sentryScope.SetUser(sentry.User{ID: scope.Username})
 		sentryScope.AddEventProcessor(func(event *sentry.Event, hint *sentry.EventHint) *sentry.Event {
-			attach(http.Client{Timeout: 10 * time.Second}, string(event.EventID), scope.ConfigFile)
+			attach(http.Client{Timeout: 10 * time.Second, Transport: requesth.Transport()}, string(event.EventID), scope.ConfigFile)
 			return event
 		})
 	})
@@ -0,0 +1,189 @@
+package shard
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/internal/junit"
+	"github.com/saucelabs/saucectl/internal/report"
+)
+
+// Cache stores the durations of test files from previous runs, which are used to balance shards.
+// Cache also implements report.Reporter, in order to record the durations of the current run. Render persists them.
+type Cache struct {
+	Path string `json:"-"`
+
+	// Durations maps the absolute path of a test file to its duration in seconds.
+	Durations map[string]float64 `json:"durations"`
+
+	// suites maps the name of a sharded suite to the keys of the test files it runs.
+	suites map[string][]string
+	dirty  bool
+	lock   sync.Mutex
+}
+
+// DefaultCachePath returns the default location of the durations cache.
+func DefaultCachePath() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".sauce", "durations.json")
+}
+
+// NewCache loads the cache from path. A missing or corrupt file results in an empty cache.
+func NewCache(path string) *Cache {
+	c := &Cache{Path: path}
+
+	if b, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(b, c); err != nil {
+			log.Warn().Err(err).Str("path", path).Msg("Ignoring corrupt durations cache.")
+		}
+	}
+	if c.Durations == nil {
+		c.Durations = map[string]float64{}
+	}
+	c.suites = map[string][]string{}
+
+	return c
+}
+
+// Duration returns the recorded duration of the test file key.
+func (c *Cache) Duration(key string) (time.Duration, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	d, ok := c.Durations[key]
+	return time.Duration(d * float64(time.Second)), ok
+}
+
+// Track registers the test files of a suite, so that their durations can be recorded once the suite has finished.
+func (c *Cache) Track(suite string, keys []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.suites[suite] = keys
+}
+
+// Add records the durations of the test files of a tracked suite. If the suite has a junit report attached, the time
+// of each <testsuite> is attributed to the test file it refers to. The remaining duration of the job is split evenly
+// across test files that the report does not cover.
+func (c *Cache) Add(t report.TestResult) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	keys, ok := c.suites[t.Name]
+	if !ok || t.Skipped || t.JobID == "" {
+		return
+	}
+
+	measured := map[string]float64{}
+	for _, a := range t.Artifacts {
+		if a.AssetType != report.JUnitArtifact {
+			continue
+		}
+		tss, err := junit.Parse(a.Body)
+		if err != nil {
+			continue
+		}
+		for _, ts := range tss.TestSuite {
+			if k := matchKey(keys, ts.File, ts.Name); k != "" {
+				measured[k] += parseSeconds(ts.Time)
+			}
+		}
+	}
+
+	var sum float64
+	for _, v := range measured {
+		sum += v
+	}
+	var remainder float64
+	if uncovered := len(keys) - len(measured); uncovered > 0 {
+		remainder = (t.Duration.Seconds() - sum) / float64(uncovered)
+	}
+
+	for _, k := range keys {
+		d, ok := measured[k]
+		if !ok {
+			d = remainder
+		}
+		if d <= 0 {
+			continue
+		}
+		// Smooth out outliers by averaging with the previous run.
+		if prev, ok := c.Durations[k]; ok {
+			d = (prev + d) / 2
+		}
+		c.Durations[k] = d
+		c.dirty = true
+	}
+}
+
+// Render persists the cache to Cache.Path, if any durations were recorded.
+func (c *Cache) Render() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.dirty {
+		return
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to encode durations cache.")
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0700); err != nil {
+		log.Warn().Err(err).Msg("Failed to create durations cache folder.")
+		return
+	}
+	if err := os.WriteFile(c.Path, b, 0600); err != nil {
+		log.Warn().Err(err).Str("path", c.Path).Msg("Failed to write durations cache.")
+		return
+	}
+	c.dirty = false
+}
+
+// Reset discards the tracked suites. Previously recorded durations are kept.
+func (c *Cache) Reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.suites = map[string][]string{}
+}
+
+// ArtifactRequirements returns a list of artifact types that are requested by this reporter. The junit report is only
+// required if there are sharded suites to record durations for.
+func (c *Cache) ArtifactRequirements() []report.ArtifactType {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if len(c.suites) == 0 {
+		return nil
+	}
+	return []report.ArtifactType{report.JUnitArtifact}
+}
+
+// matchKey returns the key that refers to the same file as any of the given candidates, which are file paths (or
+// names) as reported by a junit report.
+func matchKey(keys []string, candidates ...string) string {
+	for _, c := range candidates {
+		if c == "" {
+			continue
+		}
+		c = filepath.ToSlash(c)
+		for _, k := range keys {
+			sk := filepath.ToSlash(k)
+			if sk == c || strings.HasSuffix(sk, "/"+strings.TrimPrefix(c, "./")) {
+				return k
+			}
+		}
+	}
+	return ""
+}
+
+func parseSeconds(s string) float64 {
+	f, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return f
+}
@@ -0,0 +1,61 @@
+package shard
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/saucelabs/saucectl/internal/report"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_AddRender(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "durations.json")
+
+	c := NewCache(path)
+	assert.Empty(t, c.ArtifactRequirements())
+
+	keys := Keys(dir, []string{"e2e/a.spec.js", "e2e/b.spec.js", "e2e/c.spec.js"})
+	c.Track("suite - 1/1", keys)
+	assert.Equal(t, []report.ArtifactType{report.JUnitArtifact}, c.ArtifactRequirements())
+
+	// Untracked and skipped suites are ignored.
+	c.Add(report.TestResult{Name: "other", JobID: "1", Duration: time.Minute})
+	c.Add(report.TestResult{Name: "suite - 1/1", Skipped: true})
+
+	c.Add(report.TestResult{
+		Name:     "suite - 1/1",
+		JobID:    "2",
+		Duration: 100 * time.Second,
+		Artifacts: []report.Artifact{{
+			AssetType: report.JUnitArtifact,
+			Body: []byte(`<testsuites>
+  <testsuite name="a" file="e2e/a.spec.js" time="40.5" tests="1"></testsuite>
+  <testsuite name="b.spec.js" time="9.5" tests="1"></testsuite>
+</testsuites>`),
+		}},
+	})
+	c.Render()
+
+	got := NewCache(path)
+	assert.Equal(t, map[string]float64{keys[0]: 40.5, keys[1]: 9.5, keys[2]: 50}, got.Durations)
+
+	// Subsequent runs are averaged with previous ones.
+	got.Track("suite", keys[:1])
+	got.Add(report.TestResult{Name: "suite", JobID: "3", Duration: 20500 * time.Millisecond})
+	d, ok := got.Duration(keys[0])
+	assert.True(t, ok)
+	assert.Equal(t, 30500*time.Millisecond, d)
+}
+
+func TestNewCache(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.Empty(t, NewCache(filepath.Join(dir, "missing.json")).Durations)
+
+	corrupt := filepath.Join(dir, "corrupt.json")
+	assert.NoError(t, os.WriteFile(corrupt, []byte("{not json"), 0600))
+	assert.Empty(t, NewCache(corrupt).Durations)
+}
@@ -0,0 +1,128 @@
+package shard
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Types define how the test files of a suite are split into multiple jobs.
+const (
+	// Spec runs each test file in its own job.
+	Spec = "spec"
+	// Concurrency splits the test files into as many jobs as there is concurrency.
+	Concurrency = "concurrency"
+)
+
+// IsValid returns true if shard is one of the supported shard types or empty (i.e. sharding is disabled).
+func IsValid(shard string) bool {
+	return shard == "" || shard == Spec || shard == Concurrency
+}
+
+// Split splits files into groups according to the shard type. Files are sorted by their expected duration, longest
+// first, so that long running files are started as early as possible.
+// For Spec, each file is put into its own group. For Concurrency, files are distributed over at most ccy groups,
+// such that the expected duration of every group is roughly the same.
+// The expected duration of a file is looked up in the cache by its path, which is made absolute based on dir.
+func Split(shard string, dir string, files []string, ccy int, c *Cache) [][]string {
+	durations := estimate(dir, files, c)
+
+	sorted := make([]string, len(files))
+	copy(sorted, files)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if durations[sorted[i]] == durations[sorted[j]] {
+			return sorted[i] < sorted[j]
+		}
+		return durations[sorted[i]] > durations[sorted[j]]
+	})
+
+	if shard == Spec {
+		var groups [][]string
+		for _, f := range sorted {
+			groups = append(groups, []string{f})
+		}
+		return groups
+	}
+
+	n := ccy
+	if n > len(files) {
+		n = len(files)
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	// Greedily assign the longest remaining file to the group with the least total duration.
+	groups := make([][]string, n)
+	totals := make([]time.Duration, n)
+	for _, f := range sorted {
+		min := 0
+		for i := range totals {
+			if totals[i] < totals[min] || (totals[i] == totals[min] && len(groups[i]) < len(groups[min])) {
+				min = i
+			}
+		}
+		groups[min] = append(groups[min], f)
+		totals[min] += durations[f]
+	}
+
+	return groups
+}
+
+// Name returns the name of the replica of suite that runs the i-th of n groups of files.
+func Name(suite string, shard string, group []string, i, n int) string {
+	if shard == Spec && len(group) == 1 {
+		return fmt.Sprintf("%s - %s", suite, group[0])
+	}
+	return fmt.Sprintf("%s - %d/%d", suite, i+1, n)
+}
+
+// Keys returns the cache keys of files.
+func Keys(dir string, files []string) []string {
+	var keys []string
+	for _, f := range files {
+		keys = append(keys, Key(dir, f))
+	}
+	return keys
+}
+
+// Key returns the cache key of file, which is its absolute path.
+func Key(dir, file string) string {
+	p := filepath.Join(dir, file)
+	if abs, err := filepath.Abs(p); err == nil {
+		return abs
+	}
+	return p
+}
+
+// estimate returns the expected duration of each file. Files without a recorded duration are assumed to take as
+// long as the average of all known files.
+func estimate(dir string, files []string, c *Cache) map[string]time.Duration {
+	durations := make(map[string]time.Duration, len(files))
+
+	var known []string
+	var sum time.Duration
+	for _, f := range files {
+		if c == nil {
+			break
+		}
+		if d, ok := c.Duration(Key(dir, f)); ok {
+			durations[f] = d
+			known = append(known, f)
+			sum += d
+		}
+	}
+
+	avg := time.Second
+	if len(known) > 0 {
+		avg = sum / time.Duration(len(known))
+	}
+	for _, f := range files {
+		if _, ok := durations[f]; !ok {
+			durations[f] = avg
+		}
+	}
+
+	return durations
+}
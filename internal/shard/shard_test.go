@@ -0,0 +1,76 @@
+package shard
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	dir := t.TempDir()
+	c := NewCache(filepath.Join(dir, "durations.json"))
+	c.Durations = map[string]float64{
+		Key(dir, "a.js"): 10,
+		Key(dir, "b.js"): 60,
+		Key(dir, "c.js"): 30,
+		Key(dir, "d.js"): 20,
+	}
+
+	tests := []struct {
+		name  string
+		shard string
+		files []string
+		ccy   int
+		cache *Cache
+		want  [][]string
+	}{
+		{
+			name:  "spec orders longest first",
+			shard: Spec,
+			files: []string{"a.js", "b.js", "c.js"},
+			cache: c,
+			want:  [][]string{{"b.js"}, {"c.js"}, {"a.js"}},
+		},
+		{
+			name:  "concurrency balances durations",
+			shard: Concurrency,
+			files: []string{"a.js", "b.js", "c.js", "d.js"},
+			ccy:   2,
+			cache: c,
+			want:  [][]string{{"b.js"}, {"c.js", "d.js", "a.js"}},
+		},
+		{
+			name:  "unknown files take the average duration",
+			shard: Concurrency,
+			files: []string{"a.js", "b.js", "unknown.js"},
+			ccy:   2,
+			cache: c,
+			want:  [][]string{{"b.js"}, {"unknown.js", "a.js"}},
+		},
+		{
+			name:  "without durations files are spread evenly",
+			shard: Concurrency,
+			files: []string{"a.js", "b.js", "c.js"},
+			ccy:   5,
+			want:  [][]string{{"a.js"}, {"b.js"}, {"c.js"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(tt.shard, dir, tt.files, tt.ccy, tt.cache)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "suite - a.js", Name("suite", Spec, []string{"a.js"}, 0, 3))
+	assert.Equal(t, "suite - 2/3", Name("suite", Concurrency, []string{"a.js", "b.js"}, 1, 3))
+}
+
+func TestEstimate(t *testing.T) {
+	got := estimate(t.TempDir(), []string{"a.js"}, nil)
+	assert.Equal(t, map[string]time.Duration{"a.js": time.Second}, got)
+}
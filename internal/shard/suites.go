@@ -0,0 +1,83 @@
+package shard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/saucelabs/saucectl/internal/fpath"
+)
+
+// Suite holds the settings of a suite that sharding is concerned with.
+type Suite struct {
+	Name string
+	// Shard is the shard type. Sharding is disabled if empty.
+	Shard string
+	// Dir is the folder in which the test files are looked up.
+	Dir string
+	// Patterns match the test files of the suite, relative to Dir.
+	Patterns []string
+	// PatternsKey is the config key that Patterns are set by, e.g. "testMatch". Used for error messages.
+	PatternsKey string
+}
+
+// Replica is a copy of a suite that runs a subset of its test files.
+type Replica struct {
+	Name  string
+	Files []string
+}
+
+// Suites shards the n suites of a project, whose framework specific types are adapted by suite and add.
+// suite returns the settings of the i-th suite. add is called for every suite of the sharded project, in order, with
+// the index of the suite that it originates from. r is nil for suites that don't have sharding enabled, and the
+// replica otherwise. Test files are balanced across replicas based on the durations in c, which also tracks the
+// replicas in order to record their durations.
+func Suites(n int, ccy int, c *Cache, suite func(i int) (Suite, error), add func(i int, r *Replica)) error {
+	for i := 0; i < n; i++ {
+		s, err := suite(i)
+		if err != nil {
+			return err
+		}
+		if s.Shard == "" {
+			add(i, nil)
+			continue
+		}
+
+		replicas, err := replicate(s, ccy, c)
+		if err != nil {
+			return err
+		}
+		for j := range replicas {
+			add(i, &replicas[j])
+		}
+	}
+
+	return nil
+}
+
+// replicate splits the test files of s into replicas.
+func replicate(s Suite, ccy int, c *Cache) ([]Replica, error) {
+	if !IsValid(s.Shard) {
+		return nil, fmt.Errorf("illegal shard type '%s' in suite '%s', must be one of '%s'", s.Shard, s.Name,
+			strings.Join([]string{Spec, Concurrency}, "|"))
+	}
+
+	files, err := fpath.FindFiles(s.Dir, s.Patterns)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("suite '%s' %s does not match any files in %s", s.Name, s.PatternsKey, s.Dir)
+	}
+
+	groups := Split(s.Shard, s.Dir, files, ccy, c)
+	replicas := make([]Replica, 0, len(groups))
+	for i, g := range groups {
+		r := Replica{Name: Name(s.Name, s.Shard, g, i, len(groups)), Files: g}
+		replicas = append(replicas, r)
+		c.Track(r.Name, Keys(s.Dir, g))
+	}
+	log.Info().Str("suite", s.Name).Int("files", len(files)).Int("shards", len(groups)).Msg("Sharded suite.")
+
+	return replicas, nil
+}
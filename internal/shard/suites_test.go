@@ -0,0 +1,78 @@
+package shard
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestSuites(t *testing.T) {
+	dir := fs.NewDir(t, "project",
+		fs.WithFile("a.test.js", ""),
+		fs.WithFile("b.test.js", ""),
+	)
+	defer dir.Remove()
+
+	suites := []Suite{
+		{Name: "plain"},
+		{Name: "sharded", Shard: Spec, Dir: dir.Path(), Patterns: []string{"*.js"}},
+	}
+	type added struct {
+		i int
+		r *Replica
+	}
+	var got []added
+	c := NewCache(filepath.Join(t.TempDir(), "durations.json"))
+	err := Suites(len(suites), 2, c, func(i int) (Suite, error) {
+		return suites[i], nil
+	}, func(i int, r *Replica) {
+		got = append(got, added{i: i, r: r})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []added{
+		{i: 0},
+		{i: 1, r: &Replica{Name: "sharded - a.test.js", Files: []string{"a.test.js"}}},
+		{i: 1, r: &Replica{Name: "sharded - b.test.js", Files: []string{"b.test.js"}}},
+	}, got)
+}
+
+func TestSuites_Errors(t *testing.T) {
+	dir := fs.NewDir(t, "project", fs.WithFile("a.test.js", ""))
+	defer dir.Remove()
+
+	testCases := []struct {
+		name    string
+		suite   Suite
+		err     error
+		wantErr string
+	}{
+		{
+			name:    "illegal shard type",
+			suite:   Suite{Name: "suite", Shard: "file", Dir: dir.Path(), Patterns: []string{"*.js"}},
+			wantErr: "illegal shard type 'file' in suite 'suite', must be one of 'spec|concurrency'",
+		},
+		{
+			name:    "no matching files",
+			suite:   Suite{Name: "suite", Shard: Spec, Dir: dir.Path(), Patterns: []string{"*.ts"}, PatternsKey: "src"},
+			wantErr: "suite 'suite' src does not match any files in " + dir.Path(),
+		},
+		{
+			name:    "adapter error",
+			err:     errors.New("no config"),
+			wantErr: "no config",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Suites(1, 1, NewCache(filepath.Join(t.TempDir(), "durations.json")), func(i int) (Suite, error) {
+				return tc.suite, tc.err
+			}, func(i int, r *Replica) {
+				t.Errorf("unexpected call of add(%d, %v)", i, r)
+			})
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
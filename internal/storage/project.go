@@ -1,12 +1,26 @@
 package storage
 
+import (
+	"context"
+	"strings"
+)
+
 // ProjectUploader is the interface for uploading bundled project files, later to be used in the Sauce Cloud.
 type ProjectUploader interface {
-	Upload(name string) (ArtifactMeta, error)
-	Find(name string) (ArtifactMeta, error)
+	Upload(ctx context.Context, name string) (ArtifactMeta, error)
+	Find(ctx context.Context, name string) (ArtifactMeta, error)
 }
 
 // ArtifactMeta represents metadata of the uploaded file.
 type ArtifactMeta struct {
 	ID string
 }
+
+// Prefix is the prefix that marks a reference to a file that has already been uploaded, e.g. "storage:<id>" or
+// "storage:filename=app.apk".
+const Prefix = "storage:"
+
+// IsReference returns true if s references a file that has already been uploaded, rather than a local file.
+func IsReference(s string) bool {
+	return strings.HasPrefix(s, Prefix)
+}
@@ -1,15 +1,18 @@
 package testcafe
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/saucelabs/saucectl/internal/config"
-	"gopkg.in/yaml.v2"
+	"github.com/saucelabs/saucectl/internal/matrix"
+	"github.com/saucelabs/saucectl/internal/shard"
 )
 
 // appleDeviceRegex is a device name matching regex for apple devices (mainly ipad/iphone).
@@ -29,6 +32,7 @@ type Project struct {
 	RootDir        string             `yaml:"rootDir,omitempty" json:"rootDir"`
 	RunnerVersion  string             `yaml:"runnerVersion,omitempty" json:"runnerVersion"`
 	Artifacts      config.Artifacts   `yaml:"artifacts,omitempty" json:"artifacts"`
+	Reporters      config.Reporters   `yaml:"reporters,omitempty" json:"-"`
 	Defaults       config.Defaults    `yaml:"defaults,omitempty" json:"defaults"`
 }
 
@@ -55,8 +59,13 @@ type Suite struct {
 	DisablePageCaching bool              `yaml:"disablePageCaching,omitempty" json:"disablePageCaching"`
 	DisableScreenshots bool              `yaml:"disableScreenshots,omitempty" json:"disableScreenshots"`
 	DisableVideo       bool              `yaml:"disableVideo,omitempty" json:"disableVideo"` // This field is for sauce, not for native testcafe config.
-	Mode               string            `yaml:"mode,omitempty" json:"-"`
+	Mode               string            `yaml:"mode,omitempty" json:"-" enum:"docker,sauce"`
 	Devices            []config.Emulator `yaml:"devices,omitempty" json:"devices"`
+	Retries            *int              `yaml:"retries,omitempty" json:"-"`
+	Shard              string            `yaml:"shard,omitempty" json:"-" enum:"spec,concurrency"`
+	Browsers           []string          `yaml:"browsers,omitempty" json:"-"`
+	Platforms          []string          `yaml:"platforms,omitempty" json:"-"`
+	Timeout            time.Duration     `yaml:"timeout,omitempty" json:"-"`
 }
 
 // Screenshots represents screenshots configuration.
@@ -78,16 +87,16 @@ type Testcafe struct {
 func FromFile(cfgPath string) (Project, error) {
 	var p Project
 
-	f, err := os.Open(cfgPath)
+	b, err := config.Resolve(cfgPath)
 	if err != nil {
 		return p, fmt.Errorf("failed to locate project config: %v", err)
 	}
-	defer f.Close()
 
-	if err := yaml.NewDecoder(f).Decode(&p); err != nil {
+	if err := config.Decode(bytes.NewReader(b), &p); err != nil {
 		return Project{}, fmt.Errorf("failed to parse project config: %v", err)
 	}
 	p.ConfigFilePath = cfgPath
+	ExpandSuites(&p)
 
 	if p.Testcafe.ProjectPath == "" && p.RootDir == "" {
 		return p, fmt.Errorf("could not find 'rootDir' in config yml, 'rootDir' must be set to specify project files")
@@ -168,6 +177,26 @@ func setDefaultValues(suite *Suite) {
 	}
 }
 
+// ExpandSuites replaces each suite that lists multiple browsers or platforms with a suite per combination of them.
+func ExpandSuites(p *Project) {
+	var suites []Suite
+	matrix.Suites(len(p.Suites), func(i int) matrix.Suite {
+		s := p.Suites[i]
+		return matrix.Suite{Name: s.Name, Browser: s.BrowserName, Browsers: s.Browsers, Platform: s.PlatformName, Platforms: s.Platforms}
+	}, func(i int, c *matrix.Combination) {
+		s := p.Suites[i]
+		if c != nil {
+			s.Name = c.Name
+			s.BrowserName = c.Browser
+			s.PlatformName = c.Platform
+			s.Browsers = nil
+			s.Platforms = nil
+		}
+		suites = append(suites, s)
+	})
+	p.Suites = suites
+}
+
 // SplitSuites divided Suites to dockerSuites and sauceSuites
 func SplitSuites(p Project) (Project, Project) {
 	var dockerSuites []Suite
@@ -187,3 +216,26 @@ func SplitSuites(p Project) (Project, Project) {
 
 	return dockerProject, sauceProject
 }
+
+// ShardSuites replaces each suite that has sharding enabled with replicas of itself, each of which runs a subset of
+// the test files that match src of the original suite.
+func ShardSuites(p *Project, c *shard.Cache) error {
+	var suites []Suite
+	err := shard.Suites(len(p.Suites), p.Sauce.Concurrency, c, func(i int) (shard.Suite, error) {
+		s := p.Suites[i]
+		return shard.Suite{Name: s.Name, Shard: s.Shard, Dir: p.RootDir, Patterns: s.Src, PatternsKey: "src"}, nil
+	}, func(i int, r *shard.Replica) {
+		s := p.Suites[i]
+		if r != nil {
+			s.Name = r.Name
+			s.Src = r.Files
+		}
+		suites = append(suites, s)
+	})
+	if err != nil {
+		return err
+	}
+	p.Suites = suites
+
+	return nil
+}
@@ -1,8 +1,13 @@
 package testcafe
 
 import (
-	"github.com/stretchr/testify/assert"
+	"path/filepath"
 	"testing"
+
+	"github.com/saucelabs/saucectl/internal/config"
+	"github.com/saucelabs/saucectl/internal/shard"
+	"github.com/stretchr/testify/assert"
+	"gotest.tools/v3/fs"
 )
 
 func TestSetDefaultValues(t *testing.T) {
@@ -106,3 +111,45 @@ func Test_appleDeviceRegex(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandSuites(t *testing.T) {
+	p := Project{Suites: []Suite{
+		{Name: "single", BrowserName: "chrome"},
+		{Name: "matrix", Src: []string{"*.js"}, Browsers: []string{"chrome", "firefox"}, Platforms: []string{"Windows 10", "macOS 11.00"}},
+	}}
+
+	ExpandSuites(&p)
+
+	assert.Equal(t, []Suite{
+		{Name: "single", BrowserName: "chrome"},
+		{Name: "matrix - chrome - Windows 10", Src: []string{"*.js"}, BrowserName: "chrome", PlatformName: "Windows 10"},
+		{Name: "matrix - chrome - macOS 11.00", Src: []string{"*.js"}, BrowserName: "chrome", PlatformName: "macOS 11.00"},
+		{Name: "matrix - firefox - Windows 10", Src: []string{"*.js"}, BrowserName: "firefox", PlatformName: "Windows 10"},
+		{Name: "matrix - firefox - macOS 11.00", Src: []string{"*.js"}, BrowserName: "firefox", PlatformName: "macOS 11.00"},
+	}, p.Suites)
+}
+
+func TestShardSuites(t *testing.T) {
+	dir := fs.NewDir(t, "testcafe-project",
+		fs.WithFile("a.test.js", ""),
+		fs.WithFile("b.test.js", ""),
+		fs.WithFile("c.test.js", ""),
+	)
+	defer dir.Remove()
+
+	p := Project{
+		RootDir: dir.Path(),
+		Sauce:   config.SauceConfig{Concurrency: 2},
+		Suites: []Suite{
+			{Name: "plain", Src: []string{"*.js"}},
+			{Name: "sharded", Shard: shard.Concurrency, Src: []string{"*.js"}},
+		},
+	}
+	err := ShardSuites(&p, shard.NewCache(filepath.Join(t.TempDir(), "durations.json")))
+	assert.NoError(t, err)
+	assert.Equal(t, []Suite{
+		{Name: "plain", Src: []string{"*.js"}},
+		{Name: "sharded - 1/2", Shard: shard.Concurrency, Src: []string{"a.test.js", "c.test.js"}},
+		{Name: "sharded - 2/2", Shard: shard.Concurrency, Src: []string{"b.test.js"}},
+	}, p.Suites)
+}
@@ -1,13 +1,14 @@
 package xcuitest
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
-	"os"
 	"strings"
+	"time"
 
 	"github.com/saucelabs/saucectl/internal/config"
-	"gopkg.in/yaml.v2"
+	"github.com/saucelabs/saucectl/internal/storage"
 )
 
 var supportedDeviceTypes = []string{"ANY", "PHONE", "TABLET"}
@@ -21,6 +22,7 @@ type Project struct {
 	Xcuitest       Xcuitest           `yaml:"xcuitest,omitempty" json:"xcuitest"`
 	Suites         []Suite            `yaml:"suites,omitempty" json:"suites"`
 	Artifacts      config.Artifacts   `yaml:"artifacts,omitempty" json:"artifacts"`
+	Reporters      config.Reporters   `yaml:"reporters,omitempty" json:"-"`
 }
 
 // Xcuitest represents xcuitest apps configuration.
@@ -39,19 +41,20 @@ type Suite struct {
 	Name        string          `yaml:"name,omitempty" json:"name"`
 	Devices     []config.Device `yaml:"devices,omitempty" json:"devices"`
 	TestOptions TestOptions     `yaml:"testOptions,omitempty" json:"testOptions"`
+	Retries     *int            `yaml:"retries,omitempty" json:"-"`
+	Timeout     time.Duration   `yaml:"timeout,omitempty" json:"-"`
 }
 
 // FromFile creates a new xcuitest Project based on the filepath cfgPath.
 func FromFile(cfgPath string) (Project, error) {
 	var p Project
 
-	f, err := os.Open(cfgPath)
+	b, err := config.Resolve(cfgPath)
 	if err != nil {
 		return Project{}, fmt.Errorf("failed to locate project config: %v", err)
 	}
-	defer f.Close()
 
-	if err := yaml.NewDecoder(f).Decode(&p); err != nil {
+	if err := config.Decode(bytes.NewReader(b), &p); err != nil {
 		return Project{}, fmt.Errorf("failed to parse project config: %v", err)
 	}
 	p.ConfigFilePath = cfgPath
@@ -71,14 +74,14 @@ func Validate(p Project) error {
 	if p.Xcuitest.App == "" {
 		return errors.New("missing path to app .ipa")
 	}
-	if !strings.HasSuffix(p.Xcuitest.App, ".ipa") && !strings.HasSuffix(p.Xcuitest.App, ".app") {
+	if !storage.IsReference(p.Xcuitest.App) && !strings.HasSuffix(p.Xcuitest.App, ".ipa") && !strings.HasSuffix(p.Xcuitest.App, ".app") {
 		return fmt.Errorf("invalid application file: %s, make sure extension is .ipa or .app", p.Xcuitest.App)
 	}
 
 	if p.Xcuitest.TestApp == "" {
 		return errors.New("missing path to test app .ipa")
 	}
-	if !strings.HasSuffix(p.Xcuitest.TestApp, ".ipa") && !strings.HasSuffix(p.Xcuitest.TestApp, ".app") {
+	if !storage.IsReference(p.Xcuitest.TestApp) && !strings.HasSuffix(p.Xcuitest.TestApp, ".ipa") && !strings.HasSuffix(p.Xcuitest.TestApp, ".app") {
 		return fmt.Errorf("invalid application test file: %s, make sure extension is .ipa or .app", p.Xcuitest.TestApp)
 	}
 